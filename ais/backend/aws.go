@@ -456,6 +456,20 @@ func _getCustom(lom *core.LOM, obj *s3.GetObjectOutput) (md5 *cos.Cksum) {
 	}
 	mtime := *(obj.LastModified)
 	lom.SetCustomKey(cmn.LastModified, fmtTime(mtime))
+
+	// standard headers - to return with (S3 API) GET and HEAD
+	if v := obj.CacheControl; v != nil && *v != "" {
+		lom.SetCustomKey(cmn.S3CacheControlObjMD, *v)
+	}
+	if v := obj.ContentDisposition; v != nil && *v != "" {
+		lom.SetCustomKey(cmn.S3ContentDispositionObjMD, *v)
+	}
+	if v := obj.ContentEncoding; v != nil && *v != "" {
+		lom.SetCustomKey(cmn.S3ContentEncodingObjMD, *v)
+	}
+	if v := obj.Expires; v != nil {
+		lom.SetCustomKey(cmn.S3ExpiresObjMD, v.UTC().Format(http.TimeFormat))
+	}
 	return
 }
 
@@ -21,11 +21,13 @@ type dpq struct {
 	pid, ptime          string // proxy ID, timestamp
 	uuid                string // xaction
 	skipVC              string // (skip loading existing object's metadata)
+	storeMD5            string // QparamStoreMD5
 	archpath, archmime  string // archive
 	isGFN               string // ditto
 	origURL             string // ht://url->
 	appendTy, appendHdl string // APPEND { apc.AppendOp, ... }
 	owt                 string // object write transaction { OwtPut, ... }
+	chunk               string // QparamChunk
 	fltPresence         string // QparamFltPresence
 	dontHeadRemote      string // QparamDontHeadRemote
 	dontAddRemote       string // QparamDontAddRemote
@@ -81,6 +83,8 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			}
 		case apc.QparamSkipVC:
 			dpq.skipVC = value
+		case apc.QparamStoreMD5:
+			dpq.storeMD5 = value
 		case apc.QparamProxyID:
 			dpq.pid = value
 		case apc.QparamUnixTime:
@@ -109,6 +113,8 @@ func (dpq *dpq) parse(rawQuery string) (err error) {
 			}
 		case apc.QparamOWT:
 			dpq.owt = value
+		case apc.QparamChunk:
+			dpq.chunk = value
 
 		case apc.QparamFltPresence:
 			dpq.fltPresence = value
@@ -1003,6 +1003,9 @@ func (h *htrun) httpdaeget(w http.ResponseWriter, r *http.Request, query url.Val
 		// hide secret
 		c = *config
 		c.Auth.Secret = "**********"
+		if c.Auth.S3SecretKey != "" {
+			c.Auth.S3SecretKey = "**********"
+		}
 		body = &c
 	case apc.WhatSmap:
 		body = h.owner.smap.get()
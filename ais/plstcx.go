@@ -7,6 +7,7 @@ package ais
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"strings"
 	"sync"
 
@@ -42,6 +43,7 @@ type (
 		lsmsg   apc.LsoMsg
 		altmsg  apc.ActMsg
 		tcomsg  cmn.TCObjsMsg
+		re      *regexp.Regexp // (optional) TCBMsg.Regex
 		stopped atomic.Bool
 	}
 )
@@ -105,13 +107,28 @@ func (c *lstcx) do() (string, error) {
 	c.tsi = tsi
 	c.lsmsg.SID = tsi.ID()
 
-	// 2. ls 1st page
-	var lst *cmn.LsoResult
-	lst, err = c.p.lsObjsR(c.bckFrom, &c.lsmsg, c.smap, tsi /*designated target*/, c.config, true)
-	if err != nil {
-		return "", err
+	if regex := c.tcomsg.TCBMsg.Regex; regex != "" {
+		if c.re, err = regexp.Compile(regex); err != nil {
+			return "", err
+		}
 	}
-	if len(lst.Entries) == 0 {
+
+	// 2. ls 1st page (or, when filtering by regex, the first page that has matching names)
+	var (
+		lst *cmn.LsoResult
+		cnt int
+	)
+	for {
+		lst, err = c.p.lsObjsR(c.bckFrom, &c.lsmsg, c.smap, tsi /*designated target*/, c.config, true)
+		if err != nil {
+			return "", err
+		}
+		if cnt = c.names(lst); cnt > 0 || lst.ContinuationToken == "" {
+			break
+		}
+		c.lsmsg.ContinuationToken = lst.ContinuationToken
+	}
+	if cnt == 0 {
 		// TODO: return http status to indicate exactly that (#6393)
 		nlog.Infoln(c.amsg.Action, c.bckFrom.Cname(""), " to ", c.bckTo.Cname("")+": lso counts zero - nothing to do")
 		return c.lsmsg.UUID, nil
@@ -119,11 +136,6 @@ func (c *lstcx) do() (string, error) {
 
 	// 3. tcomsg
 	c.tcomsg.ToBck = c.bckTo.Clone()
-	lr, cnt := &c.tcomsg.ListRange, len(lst.Entries)
-	lr.ObjNames = make([]string, 0, cnt)
-	for _, e := range lst.Entries {
-		lr.ObjNames = append(lr.ObjNames, e.Name)
-	}
 
 	// 4. multi-obj action: transform/copy 1st page
 	c.altmsg.Value = &c.tcomsg
@@ -179,15 +191,29 @@ func (c *lstcx) _page() (int, error) {
 		return 0, nil
 	}
 
+	cnt := c.names(lst)
+	if cnt == 0 {
+		return 0, nil // nothing matches (regex)
+	}
+	c.altmsg.Value = &c.tcomsg
+	err = c.bcast()
+	return cnt, err
+}
+
+// (re)fill list-range names from the listed page, filtering by regex if specified
+func (c *lstcx) names(lst *cmn.LsoResult) int {
 	lr := &c.tcomsg.ListRange
 	clear(lr.ObjNames)
+	if lr.ObjNames == nil {
+		lr.ObjNames = make([]string, 0, len(lst.Entries))
+	}
 	lr.ObjNames = lr.ObjNames[:0]
 	for _, e := range lst.Entries {
-		lr.ObjNames = append(lr.ObjNames, e.Name)
+		if c.re == nil || c.re.MatchString(e.Name) {
+			lr.ObjNames = append(lr.ObjNames, e.Name)
+		}
 	}
-	c.altmsg.Value = &c.tcomsg
-	err = c.bcast()
-	return len(lr.ObjNames), err
+	return len(lr.ObjNames)
 }
 
 // calls t.httpxpost (TODO: slice of names is the only "delta" - optimize)
@@ -71,6 +71,7 @@ type (
 			mu  sync.RWMutex
 			in  atomic.Bool
 		}
+		s3pings           sync.Map    // target ID => (cached) health ping, see s3Alive
 		settingNewPrimary atomic.Bool // primary executing "set new primary" request (state)
 		readyToFastKalive atomic.Bool // primary can accept fast keepalives
 	}
@@ -1113,6 +1114,7 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 	const (
 		warnDstNotExist = "%s: destination %s doesn't exist and will be created with the %s (source bucket) props"
 		errPrependSync  = "prepend option (%q) is incompatible with the request to synchronize buckets"
+		errReplSync     = "prefix replacement option (%q) is incompatible with the request to synchronize buckets"
 	)
 	var (
 		query    = r.URL.Query()
@@ -1209,11 +1211,19 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 				p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
 				return
 			}
+			if err := tcbmsg.Validate(false); err != nil {
+				p.writeErr(w, r, err)
+				return
+			}
 		}
 		if tcbmsg.Sync && tcbmsg.Prepend != "" {
 			p.writeErrf(w, r, errPrependSync, tcbmsg.Prepend)
 			return
 		}
+		if tcbmsg.Sync && tcbmsg.ReplPrefix != "" {
+			p.writeErrf(w, r, errReplSync, tcbmsg.ReplPrefix)
+			return
+		}
 		bckTo, err = newBckFromQuname(query, true /*required*/)
 		if err != nil {
 			p.writeErr(w, r, err)
@@ -1279,6 +1289,15 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, errPrependSync, tcomsg.Prepend)
 			return
 		}
+		if tcomsg.Sync && tcomsg.ReplPrefix != "" {
+			p.writeErrf(w, r, errReplSync, tcomsg.ReplPrefix)
+			return
+		}
+		if tcomsg.NumWorkers < 0 || tcomsg.NumWorkers > apc.MaxTCONumWorkers {
+			p.writeErrf(w, r, "%s: invalid number of workers %d (expected range [0, %d])", msg.Action,
+				tcomsg.NumWorkers, apc.MaxTCONumWorkers)
+			return
+		}
 		bckTo = meta.CloneBck(&tcomsg.ToBck)
 
 		if bck.Equal(bckTo, true, true) {
@@ -1672,7 +1691,7 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 	if err != nil {
 		return
 	}
-	if msg.Action == apc.ActRenameObject {
+	if msg.Action == apc.ActRenameObject || msg.Action == apc.ActCopyObject {
 		apireq.after = 2
 	}
 	if err := p.parseReq(w, r, apireq); err != nil {
@@ -1680,7 +1699,11 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 	}
 
 	bck := apireq.bck
-	bckArgs := bctx{p: p, w: w, r: r, msg: msg, perms: apc.AcePUT, bck: bck}
+	perms := apc.AcePUT
+	if msg.Action == apc.ActCopyObject {
+		perms = apc.AceGET // source; destination - below
+	}
+	bckArgs := bctx{p: p, w: w, r: r, msg: msg, perms: perms, bck: bck}
 	bckArgs.createAIS = false
 	bckArgs.dontHeadRemote = true
 	if _, err := bckArgs.initAndTry(); err != nil {
@@ -1709,6 +1732,39 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 		p.redirectObjAction(w, r, bck, apireq.items[1], msg)
+	case apc.ActRestoreObject:
+		if !bck.IsAIS() {
+			p.writeErrActf(w, r, msg.Action, "not supported for remote buckets (%s)", bck)
+			return
+		}
+		if bck.Props.EC.Enabled {
+			p.writeErrActf(w, r, msg.Action, "not supported for erasure-coded buckets (%s)", bck)
+			return
+		}
+		p.redirectObjAction(w, r, bck, apireq.items[1], msg)
+	case apc.ActCopyObject:
+		bckTo, err := newBckFromQuname(apireq.query, true /*required*/)
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		bckTo, errCode, err := p.initBckTo(w, r, apireq.query, bckTo)
+		if err != nil {
+			return
+		}
+		if errCode == http.StatusNotFound {
+			p.writeErr(w, r, cmn.NewErrBckNotFound(bckTo.Bucket()), errCode)
+			return
+		}
+		objName, objNameTo := apireq.items[1], cos.Either(msg.Name, apireq.items[1])
+		if bck.Equal(bckTo, true, true) && objName == objNameTo {
+			p.writeErrMsg(w, r, "cannot copy "+bck.Cname(objName)+" onto itself, nothing to do")
+			return
+		}
+		if !p.isValidObjname(w, r, objNameTo) {
+			return
+		}
+		p.redirectObjAction(w, r, bck, objName, msg)
 	case apc.ActPromote:
 		if err := p.checkAccess(w, r, bck, apc.AcePromote); err != nil {
 			return
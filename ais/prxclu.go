@@ -112,6 +112,9 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		// hide secret
 		c := config.ClusterConfig
 		c.Auth.Secret = "**********"
+		if c.Auth.S3SecretKey != "" {
+			c.Auth.S3SecretKey = "**********"
+		}
 		p.writeJSON(w, r, &c, what)
 	case apc.WhatBMD, apc.WhatSmapVote, apc.WhatSnode, apc.WhatSmap:
 		p.htrun.httpdaeget(w, r, query, nil /*htext*/)
@@ -1008,6 +1011,8 @@ func (p *proxy) cluputJSON(w http.ResponseWriter, r *http.Request) {
 		p.xstart(w, r, msg)
 	case apc.ActXactStop:
 		p.xstop(w, r, msg)
+	case apc.ActXactUpdate:
+		p.xupdate(w, r, msg)
 	case apc.ActSendOwnershipTbl:
 		p.sendOwnTbl(w, r, msg)
 	default:
@@ -1275,6 +1280,37 @@ func (p *proxy) xstop(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
 	freeBcastRes(results)
 }
 
+// (currently, the only updatable runtime parameter is x-tcb max bandwidth, carried by msg.Name)
+func (p *proxy) xupdate(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
+	var xargs xact.ArgsMsg
+	if err := cos.MorphMarshal(msg.Value, &xargs); err != nil {
+		p.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, p.si, msg.Action, msg.Value, err)
+		return
+	}
+	if !xact.IsValidUUID(xargs.ID) {
+		p.writeErrf(w, r, "%s: invalid or missing xaction ID %q", msg.Action, xargs.ID)
+		return
+	}
+	if bw, err := strconv.ParseInt(msg.Name, 10, 64); err != nil || bw < 0 {
+		p.writeErrf(w, r, "%s: invalid max bandwidth %q (expecting non-negative bytes per second)", msg.Action, msg.Name)
+		return
+	}
+	body := cos.MustMarshal(apc.ActMsg{Action: msg.Action, Value: xargs, Name: msg.Name})
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathXactions.S, Body: body}
+	args.to = core.Targets
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			break
+		}
+	}
+	freeBcastRes(results)
+}
+
 func (p *proxy) rebalanceCluster(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
 	// note operational priority over config-disabled `errRebalanceDisabled`
 	if err := p.canRebalance(); err != nil && err != errRebalanceDisabled {
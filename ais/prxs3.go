@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/ais/s3"
@@ -20,9 +21,9 @@ import (
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/stats"
-	jsoniter "github.com/json-iterator/go"
 )
 
 // TODO: `checkAccess` permissions (see ais/proxy.go)
@@ -38,6 +39,14 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 		nlog.Infoln("s3Handler", p.String(), r.Method, r.URL)
 	}
 
+	// presigned request: verify (before reparsing the query)
+	if auth := &cmn.GCO.Get().Auth; auth.S3SecretKey != "" && s3.IsPresigned(r.URL.Query()) {
+		if err := s3.VerifyPresigned(r, auth.S3AccessKey, auth.S3SecretKey, time.Now()); err != nil {
+			s3.WriteErr(w, r, err, http.StatusForbidden)
+			return
+		}
+	}
+
 	// TODO: Fix the hack, https://github.com/tensorflow/tensorflow/issues/41798
 	cos.ReparseQuery(r)
 	apiItems, err := p.parseURL(w, r, apc.URLPathS3.L, 0, true)
@@ -45,6 +54,11 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if len(apiItems) > 1 && r.URL.Query().Has(s3.QparamTagging) {
+		p.objTaggingS3(w, r, apiItems)
+		return
+	}
+
 	switch r.Method {
 	case http.MethodHead:
 		if len(apiItems) == 0 {
@@ -70,7 +84,11 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 			_, cors      = q[s3.QparamCORS]
 			_, acl       = q[s3.QparamACL]
 		)
-		if lifecycle || policy || cors || acl {
+		if lifecycle {
+			p.getBckLifecycleS3(w, r, apiItems[0])
+			return
+		}
+		if policy || cors || acl {
 			p.unsupported(w, r, apiItems[0])
 			return
 		}
@@ -81,10 +99,14 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.getBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			if q.Has(s3.QparamVersions) {
+				p.listObjVersionsS3(w, r, apiItems[0], q)
+				return
+			}
 			p.listObjectsS3(w, r, apiItems[0], q)
 			return
 		}
-		// object data otherwise
+		// object data (or, with `s3.QparamAttributes`, GetObjectAttributes) otherwise
 		p.getObjS3(w, r, apiItems, q, listMultipart)
 	case http.MethodPut:
 		if len(apiItems) == 0 {
@@ -173,7 +195,8 @@ func (p *proxy) putBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 	}
 }
 
-// DELETE /s3/<bucket-name> (TODO: AWS allows to delete bucket only if it is empty)
+// DELETE /s3/<bucket-name>
+// NOTE: as per AWS, the bucket must be empty - unless forced via `apc.QparamForce`
 func (p *proxy) delBckS3(w http.ResponseWriter, r *http.Request, bucket string) {
 	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
 	if err != nil {
@@ -188,6 +211,17 @@ func (p *proxy) delBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
 		return
 	}
+	if !cos.IsParseBool(r.URL.Query().Get(apc.QparamForce)) {
+		empty, err := p.isEmptyBckS3(bck)
+		if err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		if !empty {
+			s3.WriteErr(w, r, s3.NewErrBckNotEmpty(bucket), http.StatusConflict)
+			return
+		}
+	}
 	if err := p.destroyBucket(&msg, bck); err != nil {
 		errCode := http.StatusInternalServerError
 		if _, ok := err.(*cmn.ErrBucketAlreadyExists); ok {
@@ -198,6 +232,22 @@ func (p *proxy) delBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 	}
 }
 
+// single-entry page, names only; includes remote objects that are not present in the cluster
+// (HTTP buckets - cached only, same as list-objects)
+func (p *proxy) isEmptyBckS3(bck *meta.Bck) (bool, error) {
+	lsmsg := &apc.LsoMsg{PageSize: 1, Props: apc.GetPropsName}
+	lsmsg.SetFlag(apc.LsNameOnly)
+	if bck.IsHTTP() {
+		lsmsg.SetFlag(apc.LsObjCached)
+	}
+	amsg := &apc.ActMsg{Action: apc.ActList, Value: lsmsg}
+	page, err := p.lsPage(bck, amsg, lsmsg, p.owner.smap.get())
+	if err != nil {
+		return false, err
+	}
+	return len(page.Entries) == 0, nil
+}
+
 func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, parts []string) {
 	bucket := parts[0]
 	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
@@ -211,8 +261,8 @@ func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, parts []
 	}
 	smap := p.owner.smap.get()
 	objName := s3.ObjName(parts)
-	if err := cmn.ValidateObjName(objName); err != nil {
-		s3.WriteErr(w, r, err, 0)
+	if err := s3.ValidateObjName(objName); err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
 		return
 	}
 	si, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
@@ -247,41 +297,33 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 		return
 	}
 
+	// delete objects one by one (in parallel) to report per-object outcomes;
+	// quiet mode: the response includes only failures (if any)
+	// See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteObjects.html
 	var (
-		msg   = apc.ActMsg{Action: apc.ActDeleteObjects}
-		lrMsg = &apc.ListRange{ObjNames: make([]string, 0, len(objList.Object))}
+		mu   sync.Mutex
+		smap = p.owner.smap.get()
+		wg   = cos.NewLimitedWaitGroup(cmn.MaxParallelism(), len(objList.Object))
+		all  = &s3.DeleteResult{Objs: make([]s3.DeletedObjInfo, 0, len(objList.Object))}
 	)
 	for _, obj := range objList.Object {
-		lrMsg.ObjNames = append(lrMsg.ObjNames, obj.Key)
+		wg.Add(1)
+		go func(objName string) {
+			status, err := p.delObjS3Sync(bck, objName, smap)
+			mu.Lock()
+			switch {
+			case err == nil && objList.Quiet:
+			case err == nil:
+				all.Objs = append(all.Objs, s3.DeletedObjInfo{Key: objName})
+			default:
+				all.Errs = append(all.Errs, s3.DeleteErrInfo{Key: objName, Code: s3.ErrCode(status), Message: err.Error()})
+			}
+			mu.Unlock()
+			wg.Done()
+		}(obj.Key)
 	}
-	msg.Value = lrMsg
+	wg.Wait()
 
-	// marshal+unmarshal to convince `p.listrange` to treat `listMsg` as `map[string]interface`
-	var (
-		msg2  apc.ActMsg
-		bt    = cos.MustMarshal(&msg)
-		query = make(url.Values, 1)
-	)
-	query.Set(apc.QparamProvider, apc.AIS)
-	if err := jsoniter.Unmarshal(bt, &msg2); err != nil {
-		err = fmt.Errorf(cmn.FmtErrUnmarshal, p, "list-range action message", cos.BHead(bt), err)
-		s3.WriteErr(w, r, err, 0)
-		return
-	}
-	if _, err := p.listrange(http.MethodDelete, bucket, &msg2, query); err != nil {
-		s3.WriteErr(w, r, err, 0)
-	}
-	// TODO: The client wants the response containing two lists:
-	//    - Successfully deleted objects
-	//    - Failed delete calls with error message.
-	// AIS targets do not track this info. They report a single result:
-	// whether there were any errors while deleting objects.
-	// So, we fill only "Deleted successfully" response part.
-	// See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteObjects.html
-	all := &s3.DeleteResult{Objs: make([]s3.DeletedObjInfo, 0, len(lrMsg.ObjNames))}
-	for _, name := range lrMsg.ObjNames {
-		all.Objs = append(all.Objs, s3.DeletedObjInfo{Key: name})
-	}
 	sgl := p.gmm.NewSGL(0)
 	all.MustMarshal(sgl)
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
@@ -289,6 +331,36 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 	sgl.Free()
 }
 
+// delete a single object via the target that has it; return the resulting status and error, if any
+func (p *proxy) delObjS3Sync(bck *meta.Bck, objName string, smap *smapX) (int, error) {
+	if err := cmn.ValidateObjName(objName); err != nil {
+		return http.StatusBadRequest, err
+	}
+	si, err := smap.HrwName2T(bck.MakeUname(objName))
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	q := bck.AddToQuery(make(url.Values, 4))
+	q.Set(apc.QparamProxyID, p.SID())
+	q.Set(apc.QparamUnixTime, cos.UnixNano2S(time.Now().UnixNano()))
+	cargs := allocCargs()
+	{
+		cargs.si = si
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodDelete,
+			Path:   apc.URLPathObjects.Join(bck.Name, objName),
+			Query:  q,
+			Body:   cos.MustMarshal(apc.ActMsg{Action: apc.ActDeleteObjects}),
+		}
+		cargs.timeout = apc.DefaultTimeout
+	}
+	res := p.call(cargs, smap)
+	freeCargs(cargs)
+	status, err := res.status, res.toErr()
+	freeCR(res)
+	return status, err
+}
+
 // HEAD /s3/<bucket-name>
 func (p *proxy) headBckS3(w http.ResponseWriter, r *http.Request, bucket string) {
 	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
@@ -308,9 +380,13 @@ func (p *proxy) headBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 	// might return responses such as 404 Not Found and 403 Forbidden."
 	//
 	// But it appears that Amazon always adds region to the response,
-	// and AWS CLI uses it.
+	// and AWS CLI uses it (some SDKs also cache it and enforce it).
+	region := cmn.GCO.Get().S3.Region
+	if region == "" {
+		region = s3.AISRegion
+	}
 	w.Header().Set(cos.HdrServer, s3.AISServer)
-	w.Header().Set(cos.S3HdrBckRegion, s3.AISRegion)
+	w.Header().Set(cos.S3HdrBckRegion, region)
 }
 
 // GET /s3/<bucket-name>
@@ -332,32 +408,54 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	lsmsg := &apc.LsoMsg{TimeFormat: cos.ISO8601}
 
 	// NOTE: hard-coded props as per FromLsoResult (see below)
-	lsmsg.AddProps(apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsAtime)
+	lsmsg.AddProps(apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsAtime, apc.GetPropsCustom)
 	amsg.Value = lsmsg
 
 	// as per API_ListObjectsV2.html, optional:
 	// - "max-keys"
 	// - "prefix"
 	// - "start-after"
-	// - "delimiter" (TODO: limited support: no recursion)
+	// - "delimiter" (rolls up keys into common prefixes - see s3.FromLsoResult)
 	// - "continuation-token" (NOTE: base64 encoded, as in: base64.StdEncoding.DecodeString(token)
-	// TODO:
-	// - "fetch-owner"
-	// - "encoding-type"
+	// - "fetch-owner" (cluster UUID as the owner's ID)
+	// - "encoding-type" (url)
 	s3.FillLsoMsg(q, lsmsg)
+	encodingType := q.Get(s3.QparamEncodingType)
+	if encodingType != "" && encodingType != s3.EncodingTypeURL {
+		err := fmt.Errorf("invalid encoding type %q (expecting %q)", encodingType, s3.EncodingTypeURL)
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
 
 	lst, err := p.lsAllPagesS3(bck, amsg, lsmsg)
 	if cmn.Rom.FastV(5, cos.SmoduleS3) {
 		nlog.Infoln("lsoS3", bck.Cname(""), len(lst.Entries), err)
 	}
 	if err != nil {
-		s3.WriteErr(w, r, err, 0)
-		return
+		// partial result: the pages listed so far, with `NextContinuationToken` pointing at the failed one
+		if lst == nil || lst.ContinuationToken == "" || !cmn.GCO.Get().S3.PartialList {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		nlog.Warningln(lsotag, bck.Cname(""), "returning partial result:", len(lst.Entries), "entries, err:", err)
 	}
 
 	resp := s3.NewListObjectResult(bucket)
-	resp.ContinuationToken = lsmsg.ContinuationToken
+	resp.ContinuationToken = q.Get(s3.QparamContinuationToken) // (as received - see s3.FromLsoResult)
+	resp.Prefix = lsmsg.Prefix
+	resp.Delimiter = q.Get(s3.QparamDelimiter)
+	resp.StartAfter = q.Get(s3.QparamStartAfter)
+	resp.EncodingType = encodingType
 	resp.FromLsoResult(lst, lsmsg)
+
+	var owner *s3.BckOwner
+	if cos.IsParseBool(q.Get(s3.QparamFetchOwner)) {
+		owner = &s3.BckOwner{ID: p.owner.smap.get().UUID, Name: s3.AISServer}
+	}
+	if err := resp.Finalize(owner); err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
 	sgl := p.gmm.NewSGL(0)
 	resp.MustMarshal(sgl)
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
@@ -371,6 +469,50 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	lst = nil
 }
 
+// GET /s3/<bucket-name>?versions
+// See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListObjectVersions.html
+func (p *proxy) listObjVersionsS3(w http.ResponseWriter, r *http.Request, bucket string, q url.Values) {
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	amsg := &apc.ActMsg{Action: apc.ActList}
+
+	// currently, always forwarding
+	if p.forwardCP(w, r, amsg, lsotag+" "+bck.String()) {
+		return
+	}
+
+	resp := s3.NewListVersionsResult(bucket, q)
+	if resp.EncodingType != "" && resp.EncodingType != s3.EncodingTypeURL {
+		err := fmt.Errorf("invalid encoding type %q (expecting %q)", resp.EncodingType, s3.EncodingTypeURL)
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	lsmsg := &apc.LsoMsg{TimeFormat: cos.ISO8601, Prefix: resp.Prefix}
+	lsmsg.AddProps(apc.GetPropsSize, apc.GetPropsChecksum, apc.GetPropsAtime, apc.GetPropsVersion, apc.GetPropsCustom)
+	// one version per key: `version-id-marker` (if any) can only refer to the `key-marker` itself
+	lsmsg.StartAfter = resp.KeyMarker
+	amsg.Value = lsmsg
+
+	lst, err := p.lsAllPagesS3(bck, amsg, lsmsg)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	resp.FromLsoResult(lst, lsmsg, bck.Props.Versioning.Enabled)
+	if err := resp.Finalize(); err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
 func (p *proxy) lsAllPagesS3(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg) (lst *cmn.LsoResult, _ error) {
 	smap := p.owner.smap.get()
 	for pageNum := 1; ; pageNum++ {
@@ -396,7 +538,10 @@ func (p *proxy) lsAllPagesS3(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg)
 		if page.ContinuationToken == "" { // listed all pages
 			break
 		}
+		// next page: strictly continuation token
+		// (`start-after`, if any, applies to the first page only)
 		lsmsg.ContinuationToken = page.ContinuationToken
+		lsmsg.StartAfter = ""
 		amsg.Value = lsmsg
 	}
 	return lst, nil
@@ -404,6 +549,12 @@ func (p *proxy) lsAllPagesS3(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg)
 
 // PUT /s3/<bucket-name>/<object-name>
 func (p *proxy) putObjS3(w http.ResponseWriter, r *http.Request, items []string) {
+	if q := r.URL.Query(); q.Has(s3.QparamMptPartNo) && q.Has(s3.QparamMptUploadID) {
+		// upload part, including UploadPartCopy (ie., with `cos.S3HdrObjSrc`):
+		// redirect to the target that holds the upload
+		p.handleMptUpload(w, r, items)
+		return
+	}
 	if r.Header.Get(cos.S3HdrObjSrc) == "" {
 		p.directPutObjS3(w, r, items)
 		return
@@ -446,6 +597,23 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 		return
 	}
 	objName := strings.Trim(parts[1], "/")
+	if err := s3.ValidateObjName(s3.ObjName(items)); err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+
+	// metadata directive: validate here, apply by the target (the header is retained across redirect)
+	replace, err := s3.ParseMetaDirective(r.Header)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	if !replace && bckSrc.Equal(bckDst, true, true) && objName == s3.ObjName(items) {
+		err := errors.New("copy request is illegal: copying object onto itself without changing its metadata " +
+			"(use " + s3.HdrMetaDirective + ": " + s3.MetaDirectiveReplace + ")")
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
 	si, err = smap.HrwName2T(bckSrc.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
@@ -461,6 +629,7 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 
 // PUT /s3/<bucket-name>/<object-name> - with empty `cos.S3HdrObjSrc`
 // (compare with p.copyObjS3)
+// (conditional PUT - `If-Match`, `If-None-Match: *`, and `If-Unmodified-Since` - is evaluated by the target)
 func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	bucket := items[0]
 	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
@@ -482,8 +651,13 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 		return
 	}
 	objName := s3.ObjName(items)
-	if err := cmn.ValidateObjName(objName); err != nil {
-		s3.WriteErr(w, r, err, 0)
+	if err := s3.ValidateObjName(objName); err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	// (validated by the target upon receiving the payload)
+	if _, err := s3.ParseContentMD5(r.Header); err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
 		return
 	}
 	si, netPub, err = smap.HrwMultiHome(bck.MakeUname(objName))
@@ -500,6 +674,8 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 }
 
 // GET /s3/<bucket-name>/<object-name>
+// (conditional GET - `If-None-Match` and `If-Modified-Since` - is evaluated by the target;
+// the headers are resent by clients upon 307 redirect, and copied by the reverse proxy otherwise)
 func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string, q url.Values, listMultipart bool) {
 	bucket := items[0]
 
@@ -530,11 +706,16 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	if q.Has(s3.QparamMptUploadID) && smap.CountActiveTs() > 1 {
+		p.listMptParts(w, r, bck, objName, q, smap)
+		return
+	}
 	si, netPub, err = smap.HrwMultiHome(bck.MakeUname(objName))
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	si, netPub = p.s3AltTarget(smap, bck, objName, si, netPub)
 	if cmn.Rom.FastV(5, cos.SmoduleS3) {
 		nlog.Infof("%s %s => %s", r.Method, bck.Cname(objName), si)
 	}
@@ -590,6 +771,67 @@ func (p *proxy) listMultipart(w http.ResponseWriter, r *http.Request, bck *meta.
 	sgl.Free()
 }
 
+// ListParts: bcast & aggregate - analogous to `listMultipart` above;
+// targets are asked for all parts (no pagination) that are then merged, sorted, and paginated here
+// - targets that have no parts of this upload respond with 404;
+// - any other failure fails the entire request (rather than returning an incomplete list)
+func (p *proxy) listMptParts(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, q url.Values, smap *smapX) {
+	var (
+		found bool
+		all   = &s3.ListPartsResult{Bucket: bck.Name, Key: objName, UploadID: q.Get(s3.QparamMptUploadID)}
+		seen  = make(map[int32]struct{}, 16)
+		tq    = make(url.Values, len(q))
+		args  = allocBcArgs()
+	)
+	for k, v := range q {
+		if k != s3.QparamMptMaxParts && k != s3.QparamMptPartNoMarker {
+			tq[k] = v
+		}
+	}
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: r.URL.Path, Query: tq}
+	args.network = cmn.NetPublic
+	args.smap = smap
+	args.to = core.Targets
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			if res.status == http.StatusNotFound {
+				continue // no parts on this target
+			}
+			err, status := res.toErr(), res.status
+			freeBcastRes(results)
+			s3.WriteErr(w, r, err, status)
+			return
+		}
+		parts := &s3.ListPartsResult{}
+		if err := xml.Unmarshal(res.bytes, parts); err != nil {
+			err = fmt.Errorf("upload %q: failed to parse %s response: %v", all.UploadID, res.si, err)
+			freeBcastRes(results)
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		found = true
+		for _, part := range parts.Parts {
+			if _, ok := seen[part.PartNumber]; !ok {
+				seen[part.PartNumber] = struct{}{}
+				all.Parts = append(all.Parts, part)
+			}
+		}
+	}
+	freeBcastRes(results)
+	if !found {
+		s3.WriteErr(w, r, cos.NewErrNotFound(p, "upload "+all.UploadID), http.StatusNotFound)
+		return
+	}
+	all.Paginate(q)
+	sgl := p.gmm.NewSGL(0)
+	all.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
 // HEAD /s3/<bucket-name>/<object-name>
 func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	if len(items) < 2 {
@@ -616,6 +858,7 @@ func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, http.StatusInternalServerError)
 		return
 	}
+	si, _ = p.s3AltTarget(smap, bck, objName, si, cmn.NetPublic)
 	if cmn.Rom.FastV(5, cos.SmoduleS3) {
 		nlog.Infof("%s %s => %s", r.Method, bck.Cname(objName), si)
 	}
@@ -623,6 +866,69 @@ func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string
 	p.reverseNodeRequest(w, r, si)
 }
 
+// GET and HEAD only (EC-enabled buckets): when the HRW target is down but not yet removed
+// from the cluster map, redirect to the next HRW target that is alive - the one that will
+// then restore the object from EC slices and/or replicas.
+// Not applicable to mirrored buckets - n-way mirror copies reside on the same target.
+// Liveness is cached (no per-request pings) - see s3Alive.
+func (p *proxy) s3AltTarget(smap *smapX, bck *meta.Bck, objName string, si *meta.Snode, netPub string) (*meta.Snode, string) {
+	if !bck.Props.EC.Enabled || p.s3Alive(si, smap) {
+		return si, netPub
+	}
+	count := bck.Props.EC.ParitySlices + 1
+	uname := bck.MakeUname(objName)
+	sis, err := smap.HrwTargetList(uname, min(count, smap.CountActiveTs()))
+	if err != nil {
+		return si, netPub
+	}
+	for _, tsi := range sis {
+		if tsi.ID() == si.ID() || !p.s3Alive(tsi, smap) {
+			continue
+		}
+		nlog.Warningln(p.String(), "target", si.StringEx(), "is not responding - redirecting",
+			bck.Cname(objName), "to", tsi.StringEx())
+		return tsi, cmn.NetPublic
+	}
+	return si, netPub
+}
+
+// (see s3Alive)
+type s3ping struct {
+	started int64 // mono time
+	failed  bool
+}
+
+// A target is considered alive when:
+// - it is not in maintenance (cluster map), and
+// - it's been heard from (keepalive or any intra-cluster call) within the keepalive interval.
+// Otherwise, the target gets health-pinged - at most once per interval, with the result cached
+// for all GETs and HEADs in the meantime (and with no waiting while the ping is in progress).
+func (p *proxy) s3Alive(tsi *meta.Snode, smap *smapX) bool {
+	if tsi.InMaintOrDecomm() {
+		return false
+	}
+	sid := tsi.ID()
+	if !p.keepalive.timeToPing(sid) {
+		return true
+	}
+	var (
+		now      = mono.NanoTime()
+		interval = cmn.GCO.Get().Keepalive.Proxy.Interval.D()
+	)
+	if v, ok := p.s3pings.Load(sid); ok {
+		if ping := v.(*s3ping); time.Duration(now-ping.started) < interval {
+			return !ping.failed
+		}
+	}
+	p.s3pings.Store(sid, &s3ping{started: now})
+	if _, _, err := p.reqHealth(tsi, cmn.Rom.CplaneOperation(), nil, smap); err != nil {
+		p.s3pings.Store(sid, &s3ping{started: now, failed: true})
+		return false
+	}
+	p.keepalive.heardFrom(sid)
+	return true
+}
+
 // DELETE /s3/<bucket-name>/<object-name>
 func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	bucket := items[0]
@@ -661,6 +967,38 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	p.s3Redirect(w, r, si, redirectURL, bck.Name)
 }
 
+// [METHOD] /s3/<bucket-name>/<object-name>?tagging
+// (get, replace, or remove object's tag set - redirect to the target that has the object)
+func (p *proxy) objTaggingS3(w http.ResponseWriter, r *http.Request, items []string) {
+	bck, err, errCode := meta.InitByNameOnly(items[0], p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	ace := apc.AcePUT
+	if r.Method == http.MethodGet {
+		ace = apc.AceObjHEAD
+	}
+	if err := bck.Allow(ace); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	objName := s3.ObjName(items)
+	if err := cmn.ValidateObjName(objName); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	smap := p.owner.smap.get()
+	si, err := smap.HrwName2T(bck.MakeUname(objName))
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	started := time.Now()
+	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraControl)
+	p.s3Redirect(w, r, si, redirectURL, bck.Name)
+}
+
 // GET /s3/<bucket-name>?versioning
 func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucket string) {
 	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
@@ -676,7 +1014,23 @@ func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 	sgl.Free()
 }
 
-// GET /s3/<bucket-name>?lifecycle|cors|policy|acl
+// GET /s3/<bucket-name>?lifecycle
+// (for buckets with no lifecycle, returns empty configuration with 200 - not an error)
+func (p *proxy) getBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	resp := s3.NewLifecycleConfiguration(bck)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// GET /s3/<bucket-name>?cors|policy|acl
 func (p *proxy) unsupported(w http.ResponseWriter, r *http.Request, bucket string) {
 	if _, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd); err != nil {
 		s3.WriteErr(w, r, err, errCode)
@@ -37,6 +37,25 @@ type (
 		Status string `xml:"Status"`
 	}
 
+	// Bucket lifecycle (minimal subset: expiration rules only)
+	LifecycleConfiguration struct {
+		XMLName xml.Name         `xml:"LifecycleConfiguration"`
+		Ns      string           `xml:"xmlns,attr"`
+		Rules   []*LifecycleRule `xml:"Rule"`
+	}
+	LifecycleRule struct {
+		ID         string               `xml:"ID"`
+		Filter     LifecycleFilter      `xml:"Filter"`
+		Status     string               `xml:"Status"`
+		Expiration *LifecycleExpiration `xml:"Expiration,omitempty"`
+	}
+	LifecycleFilter struct {
+		Prefix string `xml:"Prefix"`
+	}
+	LifecycleExpiration struct {
+		Days int `xml:"Days"`
+	}
+
 	// Multiple object delete request
 	Delete struct {
 		Object []*DeleteObjectInfo `xml:"Object"`
@@ -97,3 +116,33 @@ func (r *VersioningConfiguration) MustMarshal(sgl *memsys.SGL) {
 func (r *VersioningConfiguration) Enabled() bool {
 	return r.Status == versioningEnabled
 }
+
+// Translate LRU (eviction) settings into a single, informational rule: objects that were not accessed
+// for `lru.dont_evict_time` become eligible for eviction - rounded up to (the S3-required) whole days.
+// NOTE: eviction removes in-cluster copies only (the remote objects remain) - unlike S3 expiration
+// that deletes. Hence, the rule is always reported as "Disabled".
+// Empty configuration when LRU is disabled (or not applicable - ais:// buckets are never evicted).
+func NewLifecycleConfiguration(bck *meta.Bck) *LifecycleConfiguration {
+	r := &LifecycleConfiguration{Ns: s3Namespace}
+	if !bck.IsRemote() || !bck.Props.LRU.Enabled {
+		return r
+	}
+	var (
+		day  = 24 * time.Hour
+		dur  = bck.Props.LRU.DontEvictTime.D()
+		days = int((dur + day - 1) / day)
+	)
+	rule := &LifecycleRule{
+		ID:         lifecycleRuleLRU,
+		Status:     lifecycleDisabled,
+		Expiration: &LifecycleExpiration{Days: max(days, 1)},
+	}
+	r.Rules = append(r.Rules, rule)
+	return r
+}
+
+func (r *LifecycleConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
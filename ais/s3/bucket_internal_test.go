@@ -0,0 +1,35 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestLifecycleConfiguration(t *testing.T) {
+	props := &cmn.Bprops{LRU: cmn.LRUConf{Enabled: true, DontEvictTime: cos.Duration(36 * time.Hour)}}
+
+	// ais:// buckets are never evicted
+	r := NewLifecycleConfiguration(meta.NewBck("abc", apc.AIS, cmn.NsGlobal, props))
+	tassert.Errorf(t, len(r.Rules) == 0, "expected no rules, got %d", len(r.Rules))
+
+	// LRU disabled
+	r = NewLifecycleConfiguration(meta.NewBck("abc", apc.AWS, cmn.NsGlobal, &cmn.Bprops{}))
+	tassert.Errorf(t, len(r.Rules) == 0, "expected no rules, got %d", len(r.Rules))
+
+	// eviction is not deletion: informational (disabled) rule
+	r = NewLifecycleConfiguration(meta.NewBck("abc", apc.AWS, cmn.NsGlobal, props))
+	tassert.Fatalf(t, len(r.Rules) == 1, "expected 1 rule, got %d", len(r.Rules))
+	rule := r.Rules[0]
+	tassert.Errorf(t, rule.Status == lifecycleDisabled, "expected %q status, got %q", lifecycleDisabled, rule.Status)
+	tassert.Errorf(t, rule.Expiration != nil && rule.Expiration.Days == 2, "expected 2 days, got %+v", rule.Expiration)
+}
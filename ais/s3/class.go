@@ -0,0 +1,42 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Storage class: PutObject `x-amz-storage-class` (other than STANDARD) is stored as the object's
+// custom metadata (cmn.S3StorageClassObjMD) and reported back via HeadObject and listings.
+// Redundancy is configurable (see cmn.S3Conf): reduced-redundancy classes are neither
+// mirrored nor erasure coded; all other classes, including unknown ones, use bucket defaults.
+
+const HdrStorageClass = "X-Amz-Storage-Class"
+
+// returns the (uppercased) storage class to store with the object, or empty string
+// for STANDARD, unspecified, and malformed
+func ParseStorageClass(hdr http.Header) string {
+	class := strings.ToUpper(strings.TrimSpace(hdr.Get(HdrStorageClass)))
+	if class == StorageClassStandard {
+		return ""
+	}
+	for _, ch := range class {
+		if (ch < 'A' || ch > 'Z') && (ch < '0' || ch > '9') && ch != '_' {
+			return ""
+		}
+	}
+	return class
+}
+
+func StorageClass(custom cos.StrKVs) string {
+	if class := custom[cmn.S3StorageClassObjMD]; class != "" {
+		return class
+	}
+	return StorageClassStandard
+}
@@ -0,0 +1,152 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+)
+
+// Conditional PUT - see https://www.rfc-editor.org/rfc/rfc7232#section-3
+// - If-Match: existing object's ETag must match one of the listed ("*" - object must exist)
+// - If-None-Match: "*" only (as per S3) - object must not exist
+// - If-Unmodified-Since: existing object must not have been modified since (ignored when If-Match is present);
+//   compares the last modification time (see LastModified), not the access time
+
+type (
+	PutCond struct {
+		unmodSince  time.Time
+		ifMatch     []string // unquoted ETags or "*"
+		ifNoneMatch bool     // "*"
+	}
+	// S3 error code "PreconditionFailed" (412)
+	ErrPrecondFailed struct {
+		msg string
+	}
+)
+
+func (e *ErrPrecondFailed) Error() string {
+	return "at least one of the preconditions you specified did not hold: " + e.msg
+}
+
+// returns nil when the request is not conditional
+func ParsePutCond(hdr http.Header) (*PutCond, error) {
+	var (
+		cond PutCond
+		im   = hdr.Get(cos.HdrIfMatch)
+		inm  = hdr.Get(cos.HdrIfNoneMatch)
+		ius  = hdr.Get(cos.HdrIfUnmodifiedSince)
+	)
+	if im == "" && inm == "" && ius == "" {
+		return nil, nil
+	}
+	if inm != "" {
+		if strings.TrimSpace(inm) != "*" {
+			return nil, errors.New(cos.HdrIfNoneMatch + ": only '*' is supported")
+		}
+		cond.ifNoneMatch = true
+	}
+	if im != "" {
+		for _, v := range strings.Split(im, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				cond.ifMatch = append(cond.ifMatch, cmn.UnquoteCEV(v))
+			}
+		}
+	}
+	if ius != "" && len(cond.ifMatch) == 0 {
+		since, err := http.ParseTime(ius)
+		if err != nil {
+			return nil, errors.New("invalid " + cos.HdrIfUnmodifiedSince + " '" + ius + "'")
+		}
+		cond.unmodSince = since
+	}
+	return &cond, nil
+}
+
+// `lom` is the existing (loaded) object, if `exists`
+func (cond *PutCond) Check(lom *core.LOM, exists bool) error {
+	if cond.ifNoneMatch && exists {
+		return &ErrPrecondFailed{cos.HdrIfNoneMatch + ": object exists"}
+	}
+	if len(cond.ifMatch) > 0 {
+		if !exists {
+			return &ErrPrecondFailed{cos.HdrIfMatch + ": object does not exist"}
+		}
+		hdr := make(http.Header, 1)
+		SetEtag(hdr, lom)
+		etag := cmn.UnquoteCEV(hdr.Get(cos.HdrETag))
+		for _, v := range cond.ifMatch {
+			if v == "*" || (etag != "" && v == etag) {
+				return nil
+			}
+		}
+		return &ErrPrecondFailed{cos.HdrIfMatch + ": ETag does not match"}
+	}
+	if !cond.unmodSince.IsZero() && exists {
+		// (HTTP dates have 1-second resolution)
+		modified := LastModified(lom).Truncate(time.Second)
+		if modified.After(cond.unmodSince) {
+			return &ErrPrecondFailed{cos.HdrIfUnmodifiedSince + ": object was modified"}
+		}
+	}
+	return nil
+}
+
+// last modification time: as reported by the remote backend (and stored with the object), if available,
+// or else the time the object was written in-cluster (note: access time is updated on every read)
+func LastModified(lom *core.LOM) time.Time {
+	if v, ok := lom.GetCustomKey(cmn.LastModified); ok {
+		if mtime, err := time.Parse(time.RFC3339, v); err == nil {
+			return mtime
+		}
+	}
+	if finfo, err := os.Stat(lom.FQN); err == nil {
+		return finfo.ModTime()
+	}
+	return time.Unix(0, lom.AtimeUnix())
+}
+
+// Conditional GET and HEAD - see https://www.rfc-editor.org/rfc/rfc7232#section-6:
+// `If-None-Match` (ETag) takes precedence, `If-Modified-Since` is evaluated only otherwise
+// (and compares the last modification time - see LastModified).
+// Returns the headers to respond with 304 Not Modified, or nil when the object is modified.
+func NotModified(rhdr http.Header, lom *core.LOM) http.Header {
+	var (
+		hdr         = make(http.Header, 2)
+		modified    = LastModified(lom)
+		notModified bool
+	)
+	SetEtag(hdr, lom)
+	if inm := rhdr.Get(cos.HdrIfNoneMatch); inm != "" {
+		etag := cmn.UnquoteCEV(hdr.Get(cos.HdrETag))
+		for _, v := range strings.Split(inm, ",") {
+			v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+			if v == "*" || (etag != "" && cmn.UnquoteCEV(v) == etag) {
+				notModified = true
+				break
+			}
+		}
+	} else if since, err := http.ParseTime(rhdr.Get(cos.HdrIfModifiedSince)); err == nil {
+		// (HTTP dates have 1-second resolution)
+		notModified = !modified.Truncate(time.Second).After(since)
+	}
+	if !notModified {
+		return nil
+	}
+	hdr.Set(cos.S3LastModified, cos.FormatTime(modified, cos.RFC1123GMT))
+	return hdr
+}
+
+func isErrPrecondFailed(err error) bool {
+	var e *ErrPrecondFailed
+	return errors.As(err, &e)
+}
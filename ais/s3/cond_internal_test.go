@@ -0,0 +1,97 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestPutCondUnmodifiedSince(t *testing.T) {
+	out := tools.PrepareObjects(t, tools.ObjectsDesc{
+		CTs:           []tools.ContentTypeDesc{{Type: fs.ObjectType, ContentCnt: 1}},
+		MountpathsCnt: 1,
+		ObjectSize:    cos.KiB,
+	})
+	lom := core.AllocLOM("obj")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(&out.Bck))
+	tassert.CheckFatal(t, os.WriteFile(lom.FQN, []byte("data"), cos.PermRWR))
+
+	var (
+		now     = time.Now()
+		since   = now.Add(-time.Hour)
+		written = now.Add(-2 * time.Hour)
+		hdr     = http.Header{}
+	)
+	hdr.Set(cos.HdrIfUnmodifiedSince, since.UTC().Format(http.TimeFormat))
+	cond, err := ParsePutCond(hdr)
+	tassert.CheckFatal(t, err)
+
+	// written before, read after: not modified
+	tassert.CheckFatal(t, os.Chtimes(lom.FQN, written, written))
+	lom.SetAtimeUnix(now.UnixNano())
+	tassert.Errorf(t, cond.Check(lom, true) == nil, "expected recently accessed object to be unmodified")
+	tassert.Errorf(t, cond.Check(lom, false) == nil, "expected no precondition for a new object")
+
+	// written after
+	tassert.CheckFatal(t, os.Chtimes(lom.FQN, now, now))
+	err = cond.Check(lom, true)
+	tassert.Errorf(t, isErrPrecondFailed(err), "expected precondition to fail, got %v", err)
+
+	// stored (remote) last-modified takes precedence
+	lom.SetCustomKey(cmn.LastModified, written.Format(time.RFC3339))
+	tassert.Errorf(t, cond.Check(lom, true) == nil, "expected object modified remotely before %v to be unmodified", since)
+}
+
+func TestNotModifiedSince(t *testing.T) {
+	out := tools.PrepareObjects(t, tools.ObjectsDesc{
+		CTs:           []tools.ContentTypeDesc{{Type: fs.ObjectType, ContentCnt: 1}},
+		MountpathsCnt: 1,
+		ObjectSize:    cos.KiB,
+	})
+	lom := core.AllocLOM("obj")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(&out.Bck))
+	tassert.CheckFatal(t, os.WriteFile(lom.FQN, []byte("data"), cos.PermRWR))
+
+	var (
+		now     = time.Now()
+		written = now.Add(-2 * time.Hour)
+		rhdr    = http.Header{}
+	)
+	tassert.CheckFatal(t, os.Chtimes(lom.FQN, written, written))
+	lom.SetAtimeUnix(written.UnixNano())
+
+	// CDN revalidates with the Last-Modified it got
+	rhdr.Set(cos.HdrIfModifiedSince, written.UTC().Format(http.TimeFormat))
+	hdr := NotModified(rhdr, lom)
+	tassert.Fatalf(t, hdr != nil, "expected not modified")
+	lm := hdr.Get(cos.S3LastModified)
+	tassert.Errorf(t, lm == written.UTC().Format(http.TimeFormat), "expected Last-Modified %q, got %q",
+		written.UTC().Format(http.TimeFormat), lm)
+
+	// read (atime moves forward) - still not modified, same Last-Modified
+	lom.SetAtimeUnix(now.UnixNano())
+	hdr = NotModified(rhdr, lom)
+	tassert.Fatalf(t, hdr != nil, "expected recently accessed object to be unmodified")
+	tassert.Errorf(t, hdr.Get(cos.S3LastModified) == lm, "expected Last-Modified %q, got %q", lm, hdr.Get(cos.S3LastModified))
+
+	// written after
+	tassert.CheckFatal(t, os.Chtimes(lom.FQN, now, now))
+	tassert.Errorf(t, NotModified(rhdr, lom) == nil, "expected modified")
+
+	// not conditional
+	tassert.Errorf(t, NotModified(http.Header{}, lom) == nil, "expected no 304 for unconditional request")
+}
@@ -9,6 +9,9 @@ import "fmt"
 const (
 	// AWS URL params
 	QparamVersioning        = "versioning"
+	QparamVersions          = "versions"
+	QparamKeyMarker         = "key-marker"
+	QparamVersionIDMarker   = "version-id-marker"
 	QparamLifecycle         = "lifecycle"
 	QparamCORS              = "cors"
 	QparamPolicy            = "policy"
@@ -19,6 +22,13 @@ const (
 	QparamContinuationToken = "continuation-token"
 	QparamStartAfter        = "start-after"
 	QparamDelimiter         = "delimiter"
+	QparamTagging           = "tagging"
+	QparamFetchOwner        = "fetch-owner"
+	QparamEncodingType      = "encoding-type"
+	QparamAttributes        = "attributes" // GetObjectAttributes
+
+	// the only supported (and the only S3-defined) value of `encoding-type`
+	EncodingTypeURL = "url"
 
 	// multipart
 	QparamMptUploads        = "uploads"
@@ -26,6 +36,8 @@ const (
 	QparamMptPartNo         = "partNumber"
 	QparamMptMaxUploads     = "max-uploads"
 	QparamMptUploadIDMarker = "upload-id-marker"
+	QparamMptMaxParts       = "max-parts"
+	QparamMptPartNoMarker   = "part-number-marker"
 
 	QparamAccessKeyID = "AWSAccessKeyId"
 	QparamExpires     = "Expires"
@@ -42,6 +54,21 @@ const (
 	versioningEnabled  = "Enabled"
 	versioningDisabled = "Suspended"
 
+	lifecycleRuleLRU  = "ais-lru-eviction"
+	lifecycleDisabled = "Disabled" // (informational rule - not applied by S3 clients and tools)
+
+	// S3 version ID of an object in unversioned bucket
+	nullVersionID = "null"
+
+	// default storage class (for other classes, see class.go)
+	StorageClassStandard = "STANDARD"
+
+	// Object tagging limits
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-tagging.html
+	MaxTagsPerObject = 10
+	maxTagKeyLen     = 128
+	maxTagValueLen   = 256
+
 	// Maximum number of parts per upload
 	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/qfacts.html
 	MaxPartsPerUpload = 10000
@@ -0,0 +1,67 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// PutObject: `Content-MD5` (RFC 1864) - base64-encoded MD5 of the request body;
+// when present, the target computes MD5 over the received bytes and fails the PUT upon mismatch
+// See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObject.html#API_PutObject_RequestSyntax
+
+const HdrContentMD5 = "Content-Md5" // (canonical)
+
+type (
+	// S3 error code "InvalidDigest" (400)
+	ErrInvalidDigest struct {
+		value string
+	}
+	// S3 error code "BadDigest" (400)
+	ErrBadDigest struct {
+		expected, computed string // hex
+	}
+)
+
+func (e *ErrInvalidDigest) Error() string {
+	return fmt.Sprintf("the %s you specified is not valid: %q", HdrContentMD5, e.value)
+}
+
+func (e *ErrBadDigest) Error() string {
+	return fmt.Sprintf("the %s you specified did not match what we received (expected %s, computed %s)",
+		HdrContentMD5, e.expected, e.computed)
+}
+
+func NewErrBadDigest(expected, computed string) *ErrBadDigest {
+	return &ErrBadDigest{expected: expected, computed: computed}
+}
+
+// returns hex-encoded MD5 (compare with cos.CksumHash.Value), or empty string if not specified
+func ParseContentMD5(hdr http.Header) (string, error) {
+	v := strings.TrimSpace(hdr.Get(HdrContentMD5))
+	if v == "" {
+		return "", nil
+	}
+	b, err := base64.StdEncoding.DecodeString(v)
+	if err != nil || len(b) != 16 {
+		return "", &ErrInvalidDigest{v}
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func IsErrBadDigest(err error) bool {
+	var e *ErrBadDigest
+	return errors.As(err, &e)
+}
+
+func isErrInvalidDigest(err error) bool {
+	var e *ErrInvalidDigest
+	return errors.As(err, &e)
+}
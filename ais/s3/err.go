@@ -6,9 +6,12 @@ package s3
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
@@ -18,6 +21,9 @@ import (
 	"github.com/NVIDIA/aistore/memsys"
 )
 
+// see WriteRetryErr
+const RetryAfterEC = 2 * time.Second
+
 type Error struct {
 	Code      string
 	Message   string
@@ -25,6 +31,17 @@ type Error struct {
 	RequestID string `xml:"RequestId"`
 }
 
+// S3 error code "BucketNotEmpty" (DeleteBucket)
+type ErrBckNotEmpty struct {
+	bucket string
+}
+
+func NewErrBckNotEmpty(bucket string) *ErrBckNotEmpty { return &ErrBckNotEmpty{bucket} }
+
+func (e *ErrBckNotEmpty) Error() string {
+	return fmt.Sprintf("the bucket %q you tried to delete is not empty", e.bucket)
+}
+
 func (e *Error) mustMarshal(sgl *memsys.SGL) {
 	sgl.Write([]byte(xml.Header))
 	err := xml.NewEncoder(sgl).Encode(e)
@@ -63,6 +80,20 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, errCode int) {
 		out.Code = "BucketAlreadyExists"
 	case cmn.IsErrBckNotFound(err):
 		out.Code = "NoSuchBucket"
+	case isErrBckNotEmpty(err):
+		out.Code = "BucketNotEmpty"
+	case isErrAccessDenied(err):
+		out.Code = "AccessDenied"
+	case isErrSigNotMatching(err):
+		out.Code = "SignatureDoesNotMatch"
+	case isErrPrecondFailed(err):
+		out.Code = "PreconditionFailed"
+	case IsErrBadDigest(err):
+		out.Code = "BadDigest"
+	case isErrInvalidDigest(err):
+		out.Code = "InvalidDigest"
+	case in.Status == http.StatusServiceUnavailable:
+		out.Code = "ServiceUnavailable"
 	default:
 		out.Code = in.TypeCode
 	}
@@ -79,3 +110,29 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, errCode int) {
 		cmn.FreeHterr(in)
 	}
 }
+
+// 503 "ServiceUnavailable" with Retry-After - S3 clients (SDKs) retry those on their own;
+// used when the object is there but cannot be served right now - e.g., when it is being EC-recovered
+func WriteRetryErr(w http.ResponseWriter, r *http.Request, err error, retryAfter time.Duration) {
+	w.Header().Set(cos.HdrRetryAfter, strconv.Itoa(int(retryAfter/time.Second)))
+	WriteErr(w, r, err, http.StatusServiceUnavailable)
+}
+
+// S3 error code given HTTP status (used in responses that list per-object errors)
+func ErrCode(status int) string {
+	switch status {
+	case http.StatusNotFound:
+		return "NoSuchKey"
+	case http.StatusForbidden:
+		return "AccessDenied"
+	case http.StatusBadRequest:
+		return "InvalidArgument"
+	default:
+		return "InternalError"
+	}
+}
+
+func isErrBckNotEmpty(err error) bool {
+	var e *ErrBckNotEmpty
+	return errors.As(err, &e)
+}
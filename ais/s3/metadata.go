@@ -0,0 +1,80 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// User-defined object metadata: `x-amz-meta-*` headers <=> object's custom metadata
+// (with the header prefix stripped and the key lowercased, e.g. "x-amz-meta-Project: alpha" => "project" => "alpha")
+// CopyObject: `x-amz-metadata-directive` COPY (default) carries over source metadata,
+// REPLACE replaces user-defined metadata with the one provided in the request.
+// See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_CopyObject.html
+
+const (
+	HdrMetaDirective = "x-amz-metadata-directive"
+	HdrMetaPrefix    = "x-amz-meta-"
+
+	MetaDirectiveCopy    = "COPY"
+	MetaDirectiveReplace = "REPLACE"
+
+	hdrMetaAIS = HdrMetaPrefix + "ais-" // reserved (see cos.S3MetadataChecksumType)
+)
+
+// system-supported custom keys - retained when replacing user-defined metadata
+var sysObjMD = cos.NewStrSet(cmn.SourceObjMD, cmn.WebObjMD, cmn.VersionObjMD, cmn.CRC32CObjMD, cmn.MD5ObjMD,
+	cmn.ETag, cmn.OrigURLObjMD, cmn.LastModified, cmn.S3StorageClassObjMD)
+
+// returns true for REPLACE
+func ParseMetaDirective(hdr http.Header) (bool, error) {
+	switch v := hdr.Get(HdrMetaDirective); strings.ToUpper(v) {
+	case "", MetaDirectiveCopy:
+		return false, nil
+	case MetaDirectiveReplace:
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid %s %q (expecting %s or %s)", HdrMetaDirective, v,
+			MetaDirectiveCopy, MetaDirectiveReplace)
+	}
+}
+
+// extract user-defined metadata from the request headers
+func UserMetadata(hdr http.Header) cos.StrKVs {
+	var md cos.StrKVs
+	for k, vals := range hdr {
+		k = strings.ToLower(k)
+		if !strings.HasPrefix(k, HdrMetaPrefix) || strings.HasPrefix(k, hdrMetaAIS) || len(vals) == 0 {
+			continue
+		}
+		if md == nil {
+			md = make(cos.StrKVs, 4)
+		}
+		md[strings.TrimPrefix(k, HdrMetaPrefix)] = strings.Join(vals, ",")
+	}
+	return md
+}
+
+// replace (all) existing user-defined metadata with the new one;
+// retain system keys and object tags (compare w/ Tagging.Apply)
+func ReplaceMetadata(custom, user cos.StrKVs) cos.StrKVs {
+	out := make(cos.StrKVs, len(custom)+len(user))
+	for k, v := range custom {
+		if sysObjMD.Contains(k) || strings.HasPrefix(k, cmn.S3TagObjMD) {
+			out[k] = v
+		}
+	}
+	for k, v := range user {
+		if !sysObjMD.Contains(k) && !isStdHdrKey(k) {
+			out[k] = v
+		}
+	}
+	return out
+}
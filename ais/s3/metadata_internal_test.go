@@ -0,0 +1,85 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestParseMetaDirective(t *testing.T) {
+	tests := []struct {
+		val     string
+		replace bool
+		err     bool
+	}{
+		{"", false, false},
+		{MetaDirectiveCopy, false, false},
+		{"copy", false, false},
+		{MetaDirectiveReplace, true, false},
+		{"Replace", true, false},
+		{"MERGE", false, true},
+	}
+	for _, test := range tests {
+		hdr := http.Header{}
+		if test.val != "" {
+			hdr.Set(HdrMetaDirective, test.val)
+		}
+		replace, err := ParseMetaDirective(hdr)
+		if test.err {
+			tassert.Errorf(t, err != nil, "%q: expected error", test.val)
+			continue
+		}
+		tassert.CheckError(t, err)
+		tassert.Errorf(t, replace == test.replace, "%q: expected replace=%t, got %t", test.val, test.replace, replace)
+	}
+}
+
+func TestUserMetadata(t *testing.T) {
+	hdr := http.Header{}
+	tassert.Errorf(t, UserMetadata(hdr) == nil, "expected no metadata")
+
+	hdr.Set(cos.HdrContentType, cos.ContentBinary)
+	hdr.Set("X-Amz-Meta-Project", "alpha")
+	hdr.Add("X-Amz-Meta-Tags", "a")
+	hdr.Add("X-Amz-Meta-Tags", "b")
+	hdr.Set("X-Amz-Meta-Ais-Cksum-Type", cos.ChecksumMD5) // reserved
+	md := UserMetadata(hdr)
+	tassert.Fatalf(t, len(md) == 2, "expected 2 keys, got %v", md)
+	tassert.Errorf(t, md["project"] == "alpha", "expected lowercased key \"project\", got %v", md)
+	tassert.Errorf(t, md["tags"] == "a,b", "expected multiple values joined, got %v", md)
+}
+
+func TestReplaceMetadata(t *testing.T) {
+	var (
+		tag    = cmn.S3TagObjMD + "env"
+		custom = cos.StrKVs{
+			cmn.ETag:     "abc",
+			cmn.MD5ObjMD: "def",
+			tag:          "prod",
+			"project":    "alpha",
+			"owner":      "bob",
+		}
+		user = cos.StrKVs{
+			"project":               "beta",
+			"team":                  "ml",
+			cmn.ETag:                "spoofed",
+			cmn.S3CacheControlObjMD: "no-cache", // standard header (not user-defined)
+		}
+	)
+	out := ReplaceMetadata(custom, user)
+	tassert.Errorf(t, out[cmn.ETag] == "abc" && out[cmn.MD5ObjMD] == "def", "expected system keys retained, got %v", out)
+	tassert.Errorf(t, out[tag] == "prod", "expected tags retained, got %v", out)
+	tassert.Errorf(t, out["project"] == "beta" && out["team"] == "ml", "expected user metadata replaced, got %v", out)
+	_, ok := out["owner"]
+	tassert.Errorf(t, !ok, "expected old user metadata removed, got %v", out)
+	_, ok = out[cmn.S3CacheControlObjMD]
+	tassert.Errorf(t, !ok, "expected standard header key skipped, got %v", out)
+	tassert.Errorf(t, len(out) == 5, "expected 5 keys, got %v", out)
+}
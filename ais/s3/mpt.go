@@ -7,32 +7,67 @@ package s3
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// In-progress multipart uploads are persisted, to survive target restarts:
+// - manifest: <mpath>/<bucket>/%wk/s3mpt-<upload-id>.mpt (plain JSON), updated upon each added part
+// - parts:    <mpath>/<bucket>/%wk/s3mpt-<upload-id>.<part-number>.mpt
+// - both are stored on the destination object's mountpath; an upload that is not in memory
+//   (e.g., after restart) gets loaded from its manifest on first access
+// - unlike regular workfiles, the names carry no PID (see fs.WorkS3MptPrefix); abandoned uploads
+//   are removed by AbortMultipartUpload or else by 'space cleanup' - when the manifest
+//   hasn't been updated for longer than the respective TTL (see fs.NamedWorkTTL)
+// - ListMultipartUploads reports uploads in memory and on disk (see ListUploads)
+
+const (
+	mptWorkPrefix = fs.WorkS3MptPrefix
+	mptWorkSuffix = fs.WorkS3MptSuffix
 )
 
 // NOTE: xattr stores only the (*) marked attributes
 type (
 	MptPart struct {
-		MD5  string // MD5 of the part (*)
-		FQN  string // FQN of the corresponding workfile
-		Size int64  // part size in bytes (*)
-		Num  int32  // part number (*)
+		MD5  string `json:"md5"`         // MD5 of the part (*)
+		FQN  string `json:"fqn"`         // FQN of the corresponding workfile
+		Size int64  `json:"size,string"` // part size in bytes (*)
+		Num  int32  `json:"num"`         // part number (*)
 	}
 	mpt struct {
 		bckName string
 		objName string
+		fqn     string     // manifest
 		parts   []*MptPart // by part number
+		md      cos.StrKVs // Cache-Control, Expires, etc. (see StdHeaders) - to apply upon completion
 		ctime   time.Time  // InitUpload time
+		smu     sync.Mutex // serializes manifest updates (and cleanup)
+		done    bool       // completed or aborted (under smu)
 	}
 	uploads map[string]*mpt // by upload ID
+
+	// on-disk format
+	mptManifest struct {
+		Bck   string     `json:"bck"`
+		Obj   string     `json:"obj"`
+		Parts []*MptPart `json:"parts"`
+		MD    cos.StrKVs `json:"md,omitempty"`
+		Ctime int64      `json:"ctime,string"` // Unix nano
+	}
 )
 
 var (
@@ -41,43 +76,76 @@ var (
 )
 
 // Start miltipart upload
-func InitUpload(id, bckName, objName string) {
+// - md: object metadata specified by CreateMultipartUpload (to be applied by CompleteMultipartUpload)
+func InitUpload(id string, lom *core.LOM, md cos.StrKVs) error {
+	mpt := &mpt{
+		bckName: lom.Bck().Name,
+		objName: lom.ObjName,
+		fqn:     mptFQN(lom, id),
+		parts:   make([]*MptPart, 0, iniCapParts),
+		md:      md,
+		ctime:   time.Now(),
+	}
+	if err := mpt.save(mpt.manifest()); err != nil {
+		return err
+	}
 	mu.Lock()
 	if ups == nil {
 		ups = make(uploads, 8)
 	}
-	ups[id] = &mpt{
-		bckName: bckName,
-		objName: objName,
-		parts:   make([]*MptPart, 0, iniCapParts),
-		ctime:   time.Now(),
-	}
+	ups[id] = mpt
 	mu.Unlock()
+	return nil
 }
 
 // Add part to an active upload.
 // Some clients may omit size and md5. Only partNum is must-have.
-// md5 and fqn is filled by a target after successful saving the data to a workfile.
-func AddPart(id string, npart *MptPart) (err error) {
+// md5 and fqn is filled by a target after successful saving the data to a workfile
+// (which then gets renamed as a persistent part - see above).
+// A part that's uploaded again replaces the previous one.
+func AddPart(id string, lom *core.LOM, npart *MptPart) error {
+	mpt, err := getUpload(id, lom)
+	if err != nil {
+		return err
+	}
+	if mpt == nil {
+		return fmt.Errorf("upload %q not found (%s, %d)", id, npart.FQN, npart.Num)
+	}
+	pfqn := MptPartFQN(lom, id, npart.Num)
+
+	mpt.smu.Lock()
+	defer mpt.smu.Unlock()
+	if mpt.done {
+		return fmt.Errorf("upload %q is already completed or aborted (%s, %d)", id, lom.Cname(), npart.Num)
+	}
+	if err := cos.Rename(npart.FQN, pfqn); err != nil {
+		return err
+	}
+	npart.FQN = pfqn
+
 	mu.Lock()
-	mpt, ok := ups[id]
-	if !ok {
-		err = fmt.Errorf("upload %q not found (%s, %d)", id, npart.FQN, npart.Num)
+	if i := mpt.partIdx(npart.Num); i >= 0 {
+		mpt.parts[i] = npart
 	} else {
 		mpt.parts = append(mpt.parts, npart)
 	}
+	man := mpt.manifest()
 	mu.Unlock()
-	return
+
+	return mpt.save(man)
 }
 
 // TODO: compare non-zero sizes (note: s3cmd sends 0) and part.ETag as well, if specified
-func CheckParts(id string, parts []*PartInfo) ([]*MptPart, error) {
-	mu.RLock()
-	defer mu.RUnlock()
-	mpt, ok := ups[id]
-	if !ok {
+func CheckParts(id string, lom *core.LOM, parts []*PartInfo) ([]*MptPart, error) {
+	mpt, err := getUpload(id, lom)
+	if err != nil {
+		return nil, err
+	}
+	if mpt == nil {
 		return nil, fmt.Errorf("upload %q not found", id)
 	}
+	mu.RLock()
+	defer mu.RUnlock()
 	// first, check that all parts are present
 	var prev = int32(-1)
 	for _, part := range parts {
@@ -105,36 +173,62 @@ func ParsePartNum(s string) (int32, error) {
 
 // Return a sum of upload part sizes.
 // Used on upload completion to calculate the final size of the object.
-func ObjSize(id string) (size int64, err error) {
+func ObjSize(id string, lom *core.LOM) (size int64, err error) {
+	mpt, err := getUpload(id, lom)
+	if err != nil {
+		return 0, err
+	}
+	if mpt == nil {
+		return 0, fmt.Errorf("upload %q not found", id)
+	}
 	mu.RLock()
-	mpt, ok := ups[id]
-	if !ok {
-		err = fmt.Errorf("upload %q not found", id)
-	} else {
-		for _, part := range mpt.parts {
-			size += part.Size
-		}
+	for _, part := range mpt.parts {
+		size += part.Size
 	}
 	mu.RUnlock()
-	return
+	return size, nil
 }
 
-// remove all temp files and delete from the map
+// object metadata specified when the upload was initiated (nil when none)
+func UploadMD(id string, lom *core.LOM) (cos.StrKVs, error) {
+	mpt, err := getUpload(id, lom)
+	if err != nil {
+		return nil, err
+	}
+	if mpt == nil {
+		return nil, fmt.Errorf("upload %q not found", id)
+	}
+	return mpt.md, nil
+}
+
+// remove all temp files and the manifest, and delete from the map
 // if completed (i.e., not aborted): store xattr
-func CleanupUpload(id, fqn string, aborted bool) (exists bool) {
-	mu.Lock()
-	mpt, ok := ups[id]
-	if !ok {
-		mu.Unlock()
-		nlog.Warningf("fqn %s, id %s", fqn, id)
+func CleanupUpload(id string, lom *core.LOM, aborted bool) (exists bool) {
+	mpt, err := getUpload(id, lom)
+	if err != nil {
+		nlog.Warningf("%s, id %s: %v", lom, id, err)
+	}
+	if mpt == nil {
+		nlog.Warningf("%s, id %s: not found", lom, id)
+		return false
+	}
+	mpt.smu.Lock()
+	if mpt.done {
+		mpt.smu.Unlock()
 		return false
 	}
+	mpt.done = true
+	// remove manifest under lock, so that getUpload won't reload it
+	mu.Lock()
 	delete(ups, id)
+	if err := os.Remove(mpt.fqn); err != nil && !os.IsNotExist(err) {
+		nlog.Errorln(err)
+	}
 	mu.Unlock()
 
 	if !aborted {
-		if err := storeMptXattr(fqn, mpt); err != nil {
-			nlog.Warningf("fqn %s, id %s: %v", fqn, id, err)
+		if err := storeMptXattr(lom.FQN, mpt); err != nil {
+			nlog.Warningf("%s, id %s: %v", lom, id, err)
 		}
 	}
 	for _, part := range mpt.parts {
@@ -142,14 +236,20 @@ func CleanupUpload(id, fqn string, aborted bool) (exists bool) {
 			nlog.Errorln(err)
 		}
 	}
+	mpt.smu.Unlock()
 	return true
 }
 
-func ListUploads(bckName, idMarker string, maxUploads int) (result *ListMptUploadsResult) {
+// in-progress uploads of a given bucket, including those that are persisted but not (yet) loaded
+func ListUploads(bck *cmn.Bck, idMarker string, maxUploads int) (result *ListMptUploadsResult) {
+	loadUploads(bck)
+
 	mu.RLock()
 	results := make([]UploadInfoResult, 0, len(ups))
 	for id, mpt := range ups {
-		results = append(results, UploadInfoResult{Key: mpt.objName, UploadID: id, Initiated: mpt.ctime})
+		if mpt.bckName == bck.Name {
+			results = append(results, UploadInfoResult{Key: mpt.objName, UploadID: id, Initiated: mpt.ctime})
+		}
 	}
 	mu.RUnlock()
 
@@ -172,23 +272,47 @@ func ListUploads(bckName, idMarker string, maxUploads int) (result *ListMptUploa
 	if maxUploads > 0 && len(results) > maxUploads {
 		results = results[:maxUploads]
 	}
-	result = &ListMptUploadsResult{Bucket: bckName, Uploads: results, IsTruncated: from > 0}
+	result = &ListMptUploadsResult{Bucket: bck.Name, Uploads: results, IsTruncated: from > 0}
 	return
 }
 
+// sort parts by part number, and apply `part-number-marker` and `max-parts` (if specified)
+func (r *ListPartsResult) Paginate(q url.Values) {
+	sort.Slice(r.Parts, func(i, j int) bool { return r.Parts[i].PartNumber < r.Parts[j].PartNumber })
+	if s := q.Get(QparamMptPartNoMarker); s != "" {
+		if v, err := strconv.ParseInt(s, 10, 32); err == nil && v > 0 {
+			r.PartNumberMarker = int32(v)
+			i := sort.Search(len(r.Parts), func(i int) bool { return r.Parts[i].PartNumber > r.PartNumberMarker })
+			r.Parts = r.Parts[i:]
+		}
+	}
+	if s := q.Get(QparamMptMaxParts); s != "" {
+		if v, err := strconv.Atoi(s); err == nil && v > 0 {
+			r.MaxParts = v
+			if len(r.Parts) > v {
+				r.Parts = r.Parts[:v]
+				r.IsTruncated = true
+				r.NextPartNumberMarker = r.Parts[v-1].PartNumber
+			}
+		}
+	}
+}
+
 func ListParts(id string, lom *core.LOM) (parts []*PartInfo, errCode int, err error) {
-	mu.RLock()
-	mpt, ok := ups[id]
-	if !ok {
+	mpt, err := getUpload(id, lom)
+	if err != nil {
+		return nil, 0, err
+	}
+	if mpt == nil {
 		errCode = http.StatusNotFound
 		mpt, err = loadMptXattr(lom.FQN)
 		if err != nil || mpt == nil {
-			mu.RUnlock()
 			return nil, errCode, err
 		}
 		mpt.bckName, mpt.objName = lom.Bck().Name, lom.ObjName
 		mpt.ctime = lom.Atime()
 	}
+	mu.RLock()
 	parts = make([]*PartInfo, 0, len(mpt.parts))
 	for _, part := range mpt.parts {
 		parts = append(parts, &PartInfo{ETag: part.MD5, PartNumber: part.Num, Size: part.Size})
@@ -196,3 +320,118 @@ func ListParts(id string, lom *core.LOM) (parts []*PartInfo, errCode int, err er
 	mu.RUnlock()
 	return parts, errCode, err
 }
+
+//
+// persistence
+//
+
+func mptFQN(lom *core.LOM, id string) string {
+	return lom.Mountpath().MakePathFQN(lom.Bucket(), fs.WorkfileType, mptWorkPrefix+id+mptWorkSuffix)
+}
+
+func MptPartFQN(lom *core.LOM, id string, num int32) string {
+	name := mptWorkPrefix + id + "." + strconv.FormatInt(int64(num), 10) + mptWorkSuffix
+	return lom.Mountpath().MakePathFQN(lom.Bucket(), fs.WorkfileType, name)
+}
+
+// in memory or else persisted; returns (nil, nil) when not found
+func getUpload(id string, lom *core.LOM) (*mpt, error) {
+	mu.RLock()
+	up, ok := ups[id]
+	mu.RUnlock()
+	if ok {
+		return up, nil
+	}
+
+	// load under lock (compare with CleanupUpload)
+	mu.Lock()
+	defer mu.Unlock()
+	if up, ok = ups[id]; ok { // (lost the race)
+		return up, nil
+	}
+	fqn := mptFQN(lom, id)
+	man, err := loadManifest(fqn)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("upload %q: %v", id, err)
+	}
+	if man.Bck != lom.Bck().Name || man.Obj != lom.ObjName {
+		return nil, fmt.Errorf("upload %q: manifest %q refers to %s/%s (expecting %s)", id, fqn, man.Bck, man.Obj, lom.Cname())
+	}
+	up = man.toMpt(fqn)
+	_addUpload(id, up)
+	nlog.Infoln("upload", id, "loaded", lom.Cname(), "parts:", len(up.parts))
+	return up, nil
+}
+
+// load all persisted uploads of a given bucket (that are not in memory yet)
+func loadUploads(bck *cmn.Bck) {
+	for _, mi := range fs.GetAvail() {
+		dir := mi.MakePathCT(bck, fs.WorkfileType)
+		dentries, err := os.ReadDir(dir)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				nlog.Warningln("failed to read", dir, "[", err, "]")
+			}
+			continue
+		}
+		for _, de := range dentries {
+			name := de.Name()
+			if de.IsDir() || !strings.HasPrefix(name, mptWorkPrefix) || !strings.HasSuffix(name, mptWorkSuffix) {
+				continue
+			}
+			id := strings.TrimSuffix(strings.TrimPrefix(name, mptWorkPrefix), mptWorkSuffix)
+			if i := strings.LastIndexByte(id, '.'); i >= 0 {
+				if _, err := strconv.ParseUint(id[i+1:], 10, 32); err == nil {
+					continue // part
+				}
+			}
+			mu.Lock()
+			if _, ok := ups[id]; !ok {
+				fqn := filepath.Join(dir, name)
+				if man, err := loadManifest(fqn); err != nil {
+					nlog.Warningln("upload", id, "[", err, "]")
+				} else if man.Bck == bck.Name {
+					_addUpload(id, man.toMpt(fqn))
+				}
+			}
+			mu.Unlock()
+		}
+	}
+}
+
+func loadManifest(fqn string) (*mptManifest, error) {
+	man := &mptManifest{}
+	if _, err := jsp.Load(fqn, man, jsp.Plain()); err != nil {
+		if os.IsNotExist(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to load %q: %w", fqn, err)
+	}
+	return man, nil
+}
+
+func (man *mptManifest) toMpt(fqn string) *mpt {
+	return &mpt{bckName: man.Bck, objName: man.Obj, fqn: fqn, parts: man.Parts, md: man.MD, ctime: time.Unix(0, man.Ctime)}
+}
+
+// (under lock)
+func _addUpload(id string, up *mpt) {
+	if ups == nil {
+		ups = make(uploads, 8)
+	}
+	ups[id] = up
+}
+
+// (is called under lock)
+func (mpt *mpt) manifest() *mptManifest {
+	parts := make([]*MptPart, len(mpt.parts))
+	copy(parts, mpt.parts)
+	return &mptManifest{Bck: mpt.bckName, Obj: mpt.objName, Parts: parts, MD: mpt.md, Ctime: mpt.ctime.UnixNano()}
+}
+
+func (mpt *mpt) save(man *mptManifest) error {
+	return jsp.Save(mpt.fqn, man, jsp.Plain(), nil)
+}
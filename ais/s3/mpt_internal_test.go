@@ -0,0 +1,83 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// simulate target restart
+func forgetUploads() {
+	mu.Lock()
+	ups = nil
+	mu.Unlock()
+}
+
+func TestMptSaveReload(t *testing.T) {
+	out := tools.PrepareObjects(t, tools.ObjectsDesc{
+		CTs:           []tools.ContentTypeDesc{{Type: fs.ObjectType, ContentCnt: 1}},
+		MountpathsCnt: 2,
+		ObjectSize:    cos.KiB,
+	})
+	const id = "upload-id-1"
+	lom := core.AllocLOM("a/b/obj")
+	defer core.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(&out.Bck))
+
+	md := cos.StrKVs{cmn.S3CacheControlObjMD: "no-cache", cmn.S3ExpiresObjMD: "Wed, 21 Oct 2026 07:28:00 GMT"}
+	tassert.CheckFatal(t, InitUpload(id, lom, md))
+	for num := int32(1); num <= 3; num++ {
+		fqn := lom.Mountpath().MakePathFQN(lom.Bucket(), fs.WorkfileType, "part.tmp")
+		tassert.CheckFatal(t, cos.CreateDir(filepath.Dir(fqn)))
+		tassert.CheckFatal(t, os.WriteFile(fqn, make([]byte, cos.KiB), cos.PermRWR))
+		tassert.CheckFatal(t, AddPart(id, lom, &MptPart{FQN: fqn, Num: num, Size: cos.KiB, MD5: "md5"}))
+	}
+
+	// reload upon access
+	forgetUploads()
+	size, err := ObjSize(id, lom)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, size == 3*cos.KiB, "expected size %d, got %d", 3*cos.KiB, size)
+	umd, err := UploadMD(id, lom)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, reflect.DeepEqual(umd, md), "expected upload metadata %v, got %v", md, umd)
+	parts, err := CheckParts(id, lom, []*PartInfo{{PartNumber: 1}, {PartNumber: 2}, {PartNumber: 3}})
+	tassert.CheckFatal(t, err)
+	for _, part := range parts {
+		tassert.Errorf(t, cos.Stat(part.FQN) == nil, "part %d: %q not found", part.Num, part.FQN)
+	}
+
+	// list (including persisted, not loaded)
+	forgetUploads()
+	res := ListUploads(&out.Bck, "", 0)
+	tassert.Fatalf(t, len(res.Uploads) == 1, "expected 1 upload, got %d", len(res.Uploads))
+	tassert.Errorf(t, res.Uploads[0].UploadID == id && res.Uploads[0].Key == lom.ObjName, "unexpected %+v", res.Uploads[0])
+
+	// named workfiles: parts age with the manifest
+	_, base := filepath.Split(parts[1].FQN)
+	_, owner, ok := fs.NamedWorkTTL(base)
+	tassert.Errorf(t, ok && owner == mptWorkPrefix+id+mptWorkSuffix, "part %q: unexpected owner %q", base, owner)
+
+	// abort: removes everything and cannot be reloaded
+	tassert.Fatalf(t, CleanupUpload(id, lom, true /*aborted*/), "expected upload %q to exist", id)
+	for _, part := range parts {
+		tassert.Errorf(t, os.IsNotExist(cos.Stat(part.FQN)), "part %d: %q not removed", part.Num, part.FQN)
+	}
+	mpt, err := getUpload(id, lom)
+	tassert.Errorf(t, mpt == nil && err == nil, "expected upload %q to be gone (%v)", id, err)
+	tassert.Errorf(t, !CleanupUpload(id, lom, true), "expected upload %q to be gone", id)
+	err = AddPart(id, lom, &MptPart{FQN: "none", Num: 4})
+	tassert.Errorf(t, err != nil, "expected AddPart to fail")
+}
@@ -0,0 +1,59 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// S3 responses are XML 1.0 documents: object names that contain control characters or
+// invalid UTF-8 cannot be listed (with the default encoding type) without getting mangled.
+// - new objects (PUT, copy, multipart): reject such names - see ValidateObjName
+// - existing objects (e.g., ingested via native API): listing fails (with the error that
+//   suggests `encoding-type=url`) unless URL encoding is requested - see ListObjectResult.Finalize
+
+// stricter (than cmn.ValidateObjName) validation of the names of objects to be created
+func ValidateObjName(name string) error {
+	if err := cmn.ValidateObjName(name); err != nil {
+		return err
+	}
+	if !utf8.ValidString(name) {
+		return fmt.Errorf("invalid object name %q: not a valid UTF-8 string", name)
+	}
+	for i, c := range name {
+		if unicode.IsControl(c) {
+			return fmt.Errorf("invalid object name %q: control character %U at position %d", name, c, i)
+		}
+	}
+	return nil
+}
+
+// whether the name can be carried by XML 1.0 as is (i.e., escaped but not replaced)
+// see https://www.w3.org/TR/xml/#charsets
+func xmlSafe(name string) bool {
+	if !utf8.ValidString(name) {
+		return false
+	}
+	for _, c := range name {
+		switch {
+		case c == 0x09 || c == 0x0a || c == 0x0d:
+		case c >= 0x20 && c <= 0xd7ff:
+		case c >= 0xe000 && c <= 0xfffd:
+		case c >= 0x10000 && c <= 0x10ffff:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func errXMLUnsafe(name string) error {
+	return fmt.Errorf("object name %q cannot be carried by XML 1.0 (control characters or invalid UTF-8) - use %s=%s",
+		name, QparamEncodingType, EncodingTypeURL)
+}
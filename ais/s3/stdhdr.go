@@ -0,0 +1,60 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Standard (representation and caching) headers: PutObject `Cache-Control`, `Content-Disposition`,
+// `Content-Encoding`, and `Expires` are stored as the object's custom metadata (reserved keys),
+// and returned as is with GetObject and HeadObject.
+// CopyObject carries them over (COPY) or takes them from the request (REPLACE) - same as user metadata.
+
+var stdHdrs = [...]struct {
+	hdr string
+	key string
+}{
+	{cos.HdrCacheControl, cmn.S3CacheControlObjMD},
+	{cos.HdrContentDisposition, cmn.S3ContentDispositionObjMD},
+	{cos.HdrContentEncoding, cmn.S3ContentEncodingObjMD},
+	{cos.HdrExpires, cmn.S3ExpiresObjMD},
+}
+
+// request headers => custom metadata (nil when none)
+func StdHeaders(hdr http.Header) (md cos.StrKVs) {
+	for _, h := range stdHdrs {
+		v := hdr.Get(h.hdr)
+		if v == "" {
+			continue
+		}
+		if md == nil {
+			md = make(cos.StrKVs, len(stdHdrs))
+		}
+		md[h.key] = v
+	}
+	return md
+}
+
+// custom metadata => response headers
+func SetStdHeaders(hdr http.Header, custom cos.StrKVs) {
+	for _, h := range stdHdrs {
+		if v, ok := custom[h.key]; ok && v != "" {
+			hdr.Set(h.hdr, v)
+		}
+	}
+}
+
+func isStdHdrKey(key string) bool {
+	for _, h := range stdHdrs {
+		if h.key == key {
+			return true
+		}
+	}
+	return false
+}
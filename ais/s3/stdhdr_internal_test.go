@@ -0,0 +1,50 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestStdHeaders(t *testing.T) {
+	const expires = "Wed, 21 Oct 2026 07:28:00 GMT"
+
+	// none
+	md := StdHeaders(http.Header{cos.HdrContentType: []string{cos.ContentBinary}})
+	tassert.Errorf(t, md == nil, "expected no metadata, got %v", md)
+
+	// request => custom metadata
+	req := http.Header{}
+	req.Set(cos.HdrCacheControl, "max-age=3600")
+	req.Set(cos.HdrContentDisposition, `attachment; filename="a.txt"`)
+	req.Set(cos.HdrContentEncoding, "gzip")
+	req.Set(cos.HdrExpires, expires)
+	req.Set(cos.HdrContentType, cos.ContentBinary)
+	md = StdHeaders(req)
+	tassert.Fatalf(t, len(md) == 4, "expected 4 keys, got %v", md)
+	tassert.Errorf(t, md[cmn.S3CacheControlObjMD] == "max-age=3600", "Cache-Control: got %v", md)
+	tassert.Errorf(t, md[cmn.S3ExpiresObjMD] == expires, "Expires: got %v", md)
+	for k := range md {
+		tassert.Errorf(t, isStdHdrKey(k), "%q: expected reserved key", k)
+	}
+
+	// custom metadata => response (round trip), skipping user and empty values
+	md["user-key"] = "user-value"
+	md[cmn.S3ContentEncodingObjMD] = ""
+	resp := http.Header{}
+	SetStdHeaders(resp, md)
+	tassert.Errorf(t, resp.Get(cos.HdrCacheControl) == "max-age=3600", "Cache-Control: got %q", resp.Get(cos.HdrCacheControl))
+	tassert.Errorf(t, resp.Get(cos.HdrContentDisposition) == req.Get(cos.HdrContentDisposition),
+		"Content-Disposition: got %q", resp.Get(cos.HdrContentDisposition))
+	tassert.Errorf(t, resp.Get(cos.HdrExpires) == expires, "Expires: got %q", resp.Get(cos.HdrExpires))
+	tassert.Errorf(t, resp.Get(cos.HdrContentEncoding) == "", "Content-Encoding: expected none, got %q",
+		resp.Get(cos.HdrContentEncoding))
+	tassert.Errorf(t, len(resp) == 3, "expected 3 headers, got %v", resp)
+}
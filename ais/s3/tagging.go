@@ -0,0 +1,84 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Object tagging: S3 tag sets are stored as object's custom metadata
+// with `cmn.S3TagObjMD` key prefix, e.g. "s3-tag:project" => "alpha"
+// See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectTagging.html
+
+type (
+	Tagging struct {
+		XMLName xml.Name `xml:"Tagging"`
+		Ns      string   `xml:"xmlns,attr,omitempty"`
+		TagSet  []Tag    `xml:"TagSet>Tag"`
+	}
+	Tag struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	}
+)
+
+// extract tags from custom metadata (sorted by key)
+func NewTagging(custom cos.StrKVs) *Tagging {
+	r := &Tagging{Ns: s3Namespace, TagSet: []Tag{}}
+	for k, v := range custom {
+		if key, ok := strings.CutPrefix(k, cmn.S3TagObjMD); ok {
+			r.TagSet = append(r.TagSet, Tag{Key: key, Value: v})
+		}
+	}
+	sort.Slice(r.TagSet, func(i, j int) bool { return r.TagSet[i].Key < r.TagSet[j].Key })
+	return r
+}
+
+func (r *Tagging) Validate() error {
+	if len(r.TagSet) > MaxTagsPerObject {
+		return fmt.Errorf("too many tags (%d), max %d", len(r.TagSet), MaxTagsPerObject)
+	}
+	keys := make(cos.StrSet, len(r.TagSet))
+	for _, tag := range r.TagSet {
+		switch {
+		case tag.Key == "" || len(tag.Key) > maxTagKeyLen:
+			return fmt.Errorf("invalid tag key %q (expecting length between 1 and %d)", tag.Key, maxTagKeyLen)
+		case len(tag.Value) > maxTagValueLen:
+			return fmt.Errorf("tag %q: value is too long (%d, max %d)", tag.Key, len(tag.Value), maxTagValueLen)
+		case keys.Contains(tag.Key):
+			return fmt.Errorf("duplicate tag key %q", tag.Key)
+		}
+		keys.Set(tag.Key)
+	}
+	return nil
+}
+
+// replace (all) existing tags with the new ones, retain all other custom metadata
+func (r *Tagging) Apply(custom cos.StrKVs) cos.StrKVs {
+	out := make(cos.StrKVs, len(custom)+len(r.TagSet))
+	for k, v := range custom {
+		if !strings.HasPrefix(k, cmn.S3TagObjMD) {
+			out[k] = v
+		}
+	}
+	for _, tag := range r.TagSet {
+		out[cmn.S3TagObjMD+tag.Key] = tag.Value
+	}
+	return out
+}
+
+func (r *Tagging) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
@@ -5,11 +5,14 @@
 package s3
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/xml"
 	"net/http"
 	"net/url"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -31,24 +34,54 @@ type (
 		Name                  string          `xml:"Name"`
 		Ns                    string          `xml:"xmlns,attr"`
 		Prefix                string          `xml:"Prefix"`
+		Delimiter             string          `xml:"Delimiter,omitempty"` // keys that contain it (after prefix) roll up into CommonPrefixes
+		StartAfter            string          `xml:"StartAfter,omitempty"`
+		EncodingType          string          `xml:"EncodingType,omitempty"`   // "url" when keys (and the above) are URL-encoded
 		KeyCount              int             `xml:"KeyCount"`                 // number of object names in the response
 		MaxKeys               int             `xml:"MaxKeys"`                  // "The maximum number of keys returned ..." (s3)
 		IsTruncated           bool            `xml:"IsTruncated"`              // true if there are more pages to read
 		ContinuationToken     string          `xml:"ContinuationToken"`        // original ContinuationToken
 		NextContinuationToken string          `xml:"NextContinuationToken"`    // NextContinuationToken to read the next page
 		Contents              []*ObjInfo      `xml:"Contents"`                 // list of objects
-		CommonPrefixes        []*CommonPrefix `xml:"CommonPrefixes,omitempty"` // list of dirs (used with `apc.LsNoRecursion`)
+		CommonPrefixes        []*CommonPrefix `xml:"CommonPrefixes,omitempty"` // list of "directories" (when delimiter is specified)
 	}
 	ObjInfo struct {
+		Key          string    `xml:"Key"`
+		LastModified string    `xml:"LastModified"`
+		ETag         string    `xml:"ETag"`
+		Size         int64     `xml:"Size"`
+		Class        string    `xml:"StorageClass"`
+		Owner        *BckOwner `xml:"Owner,omitempty"` // iff `fetch-owner`
+	}
+	CommonPrefix struct {
+		Prefix string `xml:"Prefix"`
+	}
+
+	// List object versions response
+	// (AIS keeps a single - the latest - version of an object: one `Version` per key)
+	ListVersionsResult struct {
+		XMLName             xml.Name      `xml:"ListVersionsResult"`
+		Ns                  string        `xml:"xmlns,attr"`
+		Name                string        `xml:"Name"`
+		Prefix              string        `xml:"Prefix"`
+		KeyMarker           string        `xml:"KeyMarker"`
+		VersionIDMarker     string        `xml:"VersionIdMarker"`
+		NextKeyMarker       string        `xml:"NextKeyMarker,omitempty"`
+		NextVersionIDMarker string        `xml:"NextVersionIdMarker,omitempty"`
+		EncodingType        string        `xml:"EncodingType,omitempty"` // "url" when keys (and markers) are URL-encoded
+		MaxKeys             int           `xml:"MaxKeys"`
+		IsTruncated         bool          `xml:"IsTruncated"`
+		Versions            []*ObjVersion `xml:"Version"`
+	}
+	ObjVersion struct {
 		Key          string `xml:"Key"`
+		VersionID    string `xml:"VersionId"`
+		IsLatest     bool   `xml:"IsLatest"`
 		LastModified string `xml:"LastModified"`
 		ETag         string `xml:"ETag"`
 		Size         int64  `xml:"Size"`
 		Class        string `xml:"StorageClass"`
 	}
-	CommonPrefix struct {
-		Prefix string `xml:"Prefix"`
-	}
 
 	// Response for object copy request
 	CopyObjectResult struct {
@@ -56,6 +89,30 @@ type (
 		ETag         string `xml:"ETag"`
 	}
 
+	// Response for multipart upload part copy request (UploadPartCopy)
+	CopyPartResult struct {
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag"`
+	}
+
+	// GetObjectAttributes response
+	// (AIS checksum type and value are returned via response headers - see apc.HdrObjCksumType)
+	ObjAttrsResult struct {
+		XMLName      xml.Name     `xml:"GetObjectAttributesResponse"`
+		ETag         string       `xml:"ETag,omitempty"`
+		Checksum     *ObjCksum    `xml:"Checksum,omitempty"`
+		ObjectParts  *ObjPartsCnt `xml:"ObjectParts,omitempty"`
+		StorageClass string       `xml:"StorageClass,omitempty"`
+		ObjectSize   *int64       `xml:"ObjectSize,omitempty"`
+	}
+	// base64-encoded, as per S3 (AIS xxhash, md5, etc. have no S3 equivalent)
+	ObjCksum struct {
+		CRC32C string `xml:"ChecksumCRC32C,omitempty"`
+	}
+	ObjPartsCnt struct {
+		PartsCount int `xml:"PartsCount"`
+	}
+
 	// Multipart upload start response
 	InitiateMptUploadResult struct {
 		Bucket   string `xml:"Bucket"`
@@ -84,10 +141,14 @@ type (
 
 	// Multipart uploaded parts response
 	ListPartsResult struct {
-		Bucket   string      `xml:"Bucket"`
-		Key      string      `xml:"Key"`
-		UploadID string      `xml:"UploadId"`
-		Parts    []*PartInfo `xml:"Part"`
+		Bucket               string      `xml:"Bucket"`
+		Key                  string      `xml:"Key"`
+		UploadID             string      `xml:"UploadId"`
+		PartNumberMarker     int32       `xml:"PartNumberMarker,omitempty"`
+		NextPartNumberMarker int32       `xml:"NextPartNumberMarker,omitempty"`
+		MaxParts             int         `xml:"MaxParts,omitempty"`
+		IsTruncated          bool        `xml:"IsTruncated"`
+		Parts                []*PartInfo `xml:"Part"`
 	}
 
 	// Active upload info
@@ -110,8 +171,14 @@ type (
 	DeletedObjInfo struct {
 		Key string `xml:"Key"`
 	}
+	DeleteErrInfo struct {
+		Key     string `xml:"Key"`
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
 	DeleteResult struct {
 		Objs []DeletedObjInfo `xml:"Deleted"`
+		Errs []DeleteErrInfo  `xml:"Error"`
 	}
 )
 
@@ -128,19 +195,48 @@ func FillLsoMsg(query url.Values, msg *apc.LsoMsg) {
 	var token string
 	if token = query.Get(QparamContinuationToken); token != "" {
 		// base64 encoded, as in: base64.StdEncoding.DecodeString(token)
-		msg.ContinuationToken = token
+		msg.ContinuationToken, _ = parseLsoToken(token)
 	}
 	// `start-after` is used only when starting to list pages, subsequent next-page calls
-	// utilize `continuation-token`
-	if after := query.Get(QparamStartAfter); after != "" && token == "" {
+	// utilize `continuation-token` (and only it - the token always takes precedence)
+	switch after := query.Get(QparamStartAfter); {
+	case token != "":
+		msg.StartAfter = ""
+	case after != "":
 		msg.StartAfter = after
 	}
-	// TODO: check that the delimiter is '/' and raise an error otherwise
-	if delimiter := query.Get(QparamDelimiter); delimiter != "" {
+	// NOTE: `delimiter` is handled by the resulting `ListObjectResult` (see FromLsoResult);
+	// fast path: non-recursive listing when the delimiter is "/"
+	if query.Get(QparamDelimiter) == cos.PathSeparator {
 		msg.SetFlag(apc.LsNoRecursion)
 	}
 }
 
+// When the response ends with a common prefix, the next page may still contain keys that roll up
+// into the same prefix. To avoid returning it twice, S3 continuation token carries the last
+// common prefix along with the (native) continuation token:
+// lsoTokenTag + base64(token + '\x00' + prefix)
+const lsoTokenTag = "cp-"
+
+func makeLsoToken(token, lastPrefix string) string {
+	if token == "" || lastPrefix == "" {
+		return token
+	}
+	return lsoTokenTag + base64.RawURLEncoding.EncodeToString([]byte(token+"\x00"+lastPrefix))
+}
+
+func parseLsoToken(s string) (token, lastPrefix string) {
+	if !strings.HasPrefix(s, lsoTokenTag) {
+		return s, ""
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s[len(lsoTokenTag):])
+	if err != nil {
+		return s, ""
+	}
+	token, lastPrefix, _ = strings.Cut(string(b), "\x00")
+	return token, lastPrefix
+}
+
 func NewListObjectResult(bucket string) *ListObjectResult {
 	return &ListObjectResult{
 		Name:     bucket,
@@ -170,6 +266,18 @@ func entryToS3(entry *cmn.LsoEntry, lsmsg *apc.LsoMsg) *ObjInfo {
 		LastModified: entry.Atime,
 		ETag:         entry.Checksum,
 		Size:         entry.Size,
+		Class:        StorageClassStandard,
+	}
+	if entry.Custom != "" {
+		md := cmn.S2CustomMD(entry.Custom, entry.Version)
+		objInfo.Class = StorageClass(md)
+		// stored ETag (S3 PUT, multipart upload, remote backend) or else MD5, if available
+		// (compare with objETag)
+		if v := md[cmn.ETag]; v != "" {
+			objInfo.ETag = cmn.UnquoteCEV(v)
+		} else if v := md[cmn.MD5ObjMD]; v != "" {
+			objInfo.ETag = v
+		}
 	}
 	// Some S3 clients do not tolerate empty or missing LastModified, so fill it
 	// with a zero time if the object was not accessed yet
@@ -179,15 +287,161 @@ func entryToS3(entry *cmn.LsoEntry, lsmsg *apc.LsoMsg) *ObjInfo {
 	return objInfo
 }
 
+// NOTE: expecting r.ContinuationToken to be the one received from the client (see makeLsoToken)
 func (r *ListObjectResult) FromLsoResult(lst *cmn.LsoResult, lsmsg *apc.LsoMsg) {
-	r.KeyCount = len(lst.Entries)
 	r.IsTruncated = lst.ContinuationToken != ""
 	r.NextContinuationToken = lst.ContinuationToken
+	if r.Delimiter == "" {
+		for _, e := range lst.Entries {
+			r.Add(e, lsmsg)
+		}
+		r.KeyCount = len(lst.Entries)
+		return
+	}
+
+	// roll up all keys that contain delimiter (following the prefix) into common prefixes;
+	// the same prefix may well show up across page boundaries (and, with "/" and non-recursive
+	// listing, from multiple targets) - hence, dedup, including the previous response's last one
+	var (
+		seen          = make(cos.StrSet, 16)
+		_, lastPrefix = parseLsoToken(r.ContinuationToken)
+		last          string
+	)
+	if lastPrefix != "" {
+		seen.Set(lastPrefix)
+	}
+	for _, e := range lst.Entries {
+		var cp string
+		if e.Flags&apc.EntryIsDir != 0 {
+			cp = e.Name + cos.PathSeparator // (fast path - see FillLsoMsg)
+		} else {
+			rel := strings.TrimPrefix(e.Name, r.Prefix)
+			if i := strings.Index(rel, r.Delimiter); i >= 0 {
+				cp = r.Prefix + rel[:i+len(r.Delimiter)]
+			}
+		}
+		if cp == "" {
+			r.Contents = append(r.Contents, entryToS3(e, lsmsg))
+			last = ""
+			continue
+		}
+		if !seen.Contains(cp) {
+			seen.Set(cp)
+			r.CommonPrefixes = append(r.CommonPrefixes, &CommonPrefix{Prefix: cp})
+		}
+		last = cp
+	}
+	r.KeyCount = len(r.Contents) + len(r.CommonPrefixes)
+	r.NextContinuationToken = makeLsoToken(lst.ContinuationToken, last)
+}
+
+// - set object owner (iff requested via `fetch-owner`)
+// - URL-encode keys, prefixes, delimiter, and start-after (iff requested via `encoding-type=url`)
+// - otherwise, fail when there's a key (or common prefix) that XML cannot carry (see xmlSafe)
+// must be called after FromLsoResult
+func (r *ListObjectResult) Finalize(owner *BckOwner) error {
+	if owner != nil {
+		for _, obj := range r.Contents {
+			obj.Owner = owner
+		}
+	}
+	if r.EncodingType != EncodingTypeURL {
+		for _, obj := range r.Contents {
+			if !xmlSafe(obj.Key) {
+				return errXMLUnsafe(obj.Key)
+			}
+		}
+		for _, cp := range r.CommonPrefixes {
+			if !xmlSafe(cp.Prefix) {
+				return errXMLUnsafe(cp.Prefix)
+			}
+		}
+		return nil
+	}
+	r.Prefix = url.QueryEscape(r.Prefix)
+	r.Delimiter = url.QueryEscape(r.Delimiter)
+	r.StartAfter = url.QueryEscape(r.StartAfter)
+	for _, obj := range r.Contents {
+		obj.Key = url.QueryEscape(obj.Key)
+	}
+	for _, cp := range r.CommonPrefixes {
+		cp.Prefix = url.QueryEscape(cp.Prefix)
+	}
+	return nil
+}
+
+func NewListVersionsResult(bucket string, query url.Values) *ListVersionsResult {
+	r := &ListVersionsResult{
+		Name:            bucket,
+		Ns:              s3Namespace,
+		Prefix:          query.Get(QparamPrefix),
+		KeyMarker:       query.Get(QparamKeyMarker),
+		VersionIDMarker: query.Get(QparamVersionIDMarker),
+		EncodingType:    query.Get(QparamEncodingType),
+		MaxKeys:         1000,
+		Versions:        make([]*ObjVersion, 0),
+	}
+	if maxKeys, err := strconv.Atoi(query.Get(QparamMaxKeys)); err == nil && maxKeys > 0 && maxKeys < r.MaxKeys {
+		r.MaxKeys = maxKeys
+	}
+	return r
+}
+
+// NOTE: expecting the entries to be sorted and to follow `key-marker` (compare with `start-after`)
+// - versioned: whether the bucket is versioned, otherwise "null" version IDs
+func (r *ListVersionsResult) FromLsoResult(lst *cmn.LsoResult, lsmsg *apc.LsoMsg, versioned bool) {
 	for _, e := range lst.Entries {
-		r.Add(e, lsmsg)
+		if e.Flags&apc.EntryIsDir != 0 {
+			continue
+		}
+		if len(r.Versions) >= r.MaxKeys {
+			r.IsTruncated = true
+			last := r.Versions[len(r.Versions)-1]
+			r.NextKeyMarker, r.NextVersionIDMarker = last.Key, last.VersionID
+			return
+		}
+		obj := entryToS3(e, lsmsg)
+		v := &ObjVersion{
+			Key:          obj.Key,
+			VersionID:    e.Version,
+			IsLatest:     true,
+			LastModified: obj.LastModified,
+			ETag:         obj.ETag,
+			Size:         obj.Size,
+			Class:        obj.Class,
+		}
+		if !versioned || v.VersionID == "" {
+			v.VersionID = nullVersionID
+		}
+		r.Versions = append(r.Versions, v)
 	}
 }
 
+// same as ListObjectResult.Finalize
+func (r *ListVersionsResult) Finalize() error {
+	if r.EncodingType != EncodingTypeURL {
+		for _, v := range r.Versions {
+			if !xmlSafe(v.Key) {
+				return errXMLUnsafe(v.Key)
+			}
+		}
+		return nil
+	}
+	r.Prefix = url.QueryEscape(r.Prefix)
+	r.KeyMarker = url.QueryEscape(r.KeyMarker)
+	r.NextKeyMarker = url.QueryEscape(r.NextKeyMarker)
+	for _, v := range r.Versions {
+		v.Key = url.QueryEscape(v.Key)
+	}
+	return nil
+}
+
+func (r *ListVersionsResult) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
 func SetEtag(hdr http.Header, lom *core.LOM) {
 	if hdr.Get(cos.S3CksumHeader) != "" {
 		return
@@ -201,12 +455,65 @@ func SetEtag(hdr http.Header, lom *core.LOM) {
 	}
 }
 
+// given requested (x-amz-object-attributes) attributes, e.g. "ETag,Checksum,ObjectSize"
+func NewObjAttrsResult(lom *core.LOM, attrs string) *ObjAttrsResult {
+	r := &ObjAttrsResult{}
+	for _, a := range strings.Split(attrs, ",") {
+		switch strings.TrimSpace(a) {
+		case "ETag":
+			r.ETag = objETag(lom)
+		case "Checksum":
+			if cksum := lom.Checksum(); cksum.Type() == cos.ChecksumCRC32C {
+				if b, err := hex.DecodeString(cksum.Value()); err == nil {
+					r.Checksum = &ObjCksum{CRC32C: base64.StdEncoding.EncodeToString(b)}
+				}
+			}
+		case "ObjectParts":
+			etag := objETag(lom)
+			if i := strings.LastIndex(etag, cmn.AwsMultipartDelim); i > 0 {
+				if n, err := strconv.Atoi(etag[i+1:]); err == nil {
+					r.ObjectParts = &ObjPartsCnt{PartsCount: n}
+				}
+			}
+		case "StorageClass":
+			r.StorageClass = StorageClass(lom.GetCustomMD())
+		case "ObjectSize":
+			size := lom.SizeBytes()
+			r.ObjectSize = &size
+		}
+	}
+	return r
+}
+
+// including multipart ETag (compare w/ SetEtag)
+func objETag(lom *core.LOM) string {
+	if v, exists := lom.GetCustomKey(cmn.ETag); exists {
+		return cmn.UnquoteCEV(v)
+	}
+	if cksum := lom.Checksum(); cksum.Type() == cos.ChecksumMD5 {
+		return cksum.Value()
+	}
+	return ""
+}
+
+func (r *ObjAttrsResult) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
 func (r *CopyObjectResult) MustMarshal(sgl *memsys.SGL) {
 	sgl.Write([]byte(xml.Header))
 	err := xml.NewEncoder(sgl).Encode(r)
 	debug.AssertNoErr(err)
 }
 
+func (r *CopyPartResult) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
 func (r *InitiateMptUploadResult) MustMarshal(sgl *memsys.SGL) {
 	sgl.Write([]byte(xml.Header))
 	err := xml.NewEncoder(sgl).Encode(r)
@@ -0,0 +1,287 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestDelimiterRollup(t *testing.T) {
+	var (
+		names = []string{"a/b/1", "a/b/2", "a/c", "a/d/e/3", "a/x.y/4", "b/5"}
+		lst   = &cmn.LsoResult{}
+		lsmsg = &apc.LsoMsg{}
+	)
+	tests := []struct {
+		prefix, delim string
+		contents      []string
+		prefixes      []string
+	}{
+		{"", "", names, nil},
+		{"", "/", nil, []string{"a/", "b/"}},
+		{"a/", "/", []string{"a/c"}, []string{"a/b/", "a/d/", "a/x.y/"}},
+		{"a/", ".", []string{"a/b/1", "a/b/2", "a/c", "a/d/e/3"}, []string{"a/x."}},
+	}
+	for _, test := range tests {
+		r := NewListObjectResult("bucket")
+		r.Prefix, r.Delimiter = test.prefix, test.delim
+		lst.Entries = lst.Entries[:0]
+		for _, name := range names {
+			if cmn.ObjHasPrefix(name, test.prefix) {
+				lst.Entries = append(lst.Entries, &cmn.LsoEntry{Name: name})
+			}
+		}
+		r.FromLsoResult(lst, lsmsg)
+		if len(r.Contents) != len(test.contents) || len(r.CommonPrefixes) != len(test.prefixes) {
+			t.Fatalf("prefix %q, delimiter %q: expected %d/%d, got %d/%d", test.prefix, test.delim,
+				len(test.contents), len(test.prefixes), len(r.Contents), len(r.CommonPrefixes))
+		}
+		for i, obj := range r.Contents {
+			if obj.Key != test.contents[i] {
+				t.Errorf("prefix %q, delimiter %q: expected key %q, got %q", test.prefix, test.delim, test.contents[i], obj.Key)
+			}
+		}
+		for i, cp := range r.CommonPrefixes {
+			if cp.Prefix != test.prefixes[i] {
+				t.Errorf("prefix %q, delimiter %q: expected common prefix %q, got %q", test.prefix, test.delim,
+					test.prefixes[i], cp.Prefix)
+			}
+		}
+		if r.KeyCount != len(r.Contents)+len(r.CommonPrefixes) {
+			t.Errorf("prefix %q, delimiter %q: invalid key count %d", test.prefix, test.delim, r.KeyCount)
+		}
+	}
+}
+
+// delimiter "/": non-recursive listing (directories) and dedup across targets
+func TestDelimiterNoRecursion(t *testing.T) {
+	query := url.Values{QparamDelimiter: []string{"/"}, QparamPrefix: []string{"a/"}}
+	msg := &apc.LsoMsg{}
+	FillLsoMsg(query, msg)
+	if !msg.IsFlagSet(apc.LsNoRecursion) {
+		t.Fatal("expecting non-recursive listing given delimiter '/'")
+	}
+	msg = &apc.LsoMsg{}
+	FillLsoMsg(url.Values{QparamDelimiter: []string{"."}}, msg)
+	if msg.IsFlagSet(apc.LsNoRecursion) {
+		t.Fatal("expecting recursive listing given delimiter '.'")
+	}
+
+	lst := &cmn.LsoResult{Entries: []*cmn.LsoEntry{
+		{Name: "a/b", Flags: apc.EntryIsDir},
+		{Name: "a/b", Flags: apc.EntryIsDir}, // (another target)
+		{Name: "a/c"},
+		{Name: "a/d", Flags: apc.EntryIsDir},
+	}}
+	r := NewListObjectResult("bucket")
+	r.Prefix, r.Delimiter = "a/", "/"
+	r.FromLsoResult(lst, msg)
+	if len(r.Contents) != 1 || r.Contents[0].Key != "a/c" {
+		t.Fatalf("expected contents [a/c], got %d", len(r.Contents))
+	}
+	if len(r.CommonPrefixes) != 2 || r.CommonPrefixes[0].Prefix != "a/b/" || r.CommonPrefixes[1].Prefix != "a/d/" {
+		t.Fatalf("expected common prefixes [a/b/ a/d/], got %d", len(r.CommonPrefixes))
+	}
+}
+
+// common prefix that spans responses is returned only once
+func TestDelimiterContinuation(t *testing.T) {
+	var (
+		lsmsg = &apc.LsoMsg{}
+		page1 = &cmn.LsoResult{
+			Entries:           []*cmn.LsoEntry{{Name: "a.1"}, {Name: "b.1"}, {Name: "b.2"}},
+			ContinuationToken: "b.2",
+		}
+		page2 = &cmn.LsoResult{Entries: []*cmn.LsoEntry{{Name: "b.3"}, {Name: "c"}, {Name: "d.1"}}}
+	)
+	r := NewListObjectResult("bucket")
+	r.Delimiter = "."
+	r.FromLsoResult(page1, lsmsg)
+	if len(r.CommonPrefixes) != 2 || !r.IsTruncated {
+		t.Fatalf("page 1: expected 2 common prefixes (truncated), got %d", len(r.CommonPrefixes))
+	}
+	token := r.NextContinuationToken
+	if token == page1.ContinuationToken {
+		t.Fatalf("page 1: expected continuation token to carry the last common prefix")
+	}
+
+	// next request
+	query := url.Values{QparamContinuationToken: []string{token}, QparamDelimiter: []string{"."}}
+	msg := &apc.LsoMsg{}
+	FillLsoMsg(query, msg)
+	if msg.ContinuationToken != page1.ContinuationToken {
+		t.Fatalf("expected native token %q, got %q", page1.ContinuationToken, msg.ContinuationToken)
+	}
+	r = NewListObjectResult("bucket")
+	r.Delimiter, r.ContinuationToken = ".", token
+	r.FromLsoResult(page2, msg)
+	if len(r.Contents) != 1 || len(r.CommonPrefixes) != 1 || r.CommonPrefixes[0].Prefix != "d." {
+		t.Fatalf("page 2: expected [c] and [d.], got %d/%d", len(r.Contents), len(r.CommonPrefixes))
+	}
+	if r.IsTruncated || r.NextContinuationToken != "" {
+		t.Fatalf("page 2: expected last page, got %q", r.NextContinuationToken)
+	}
+}
+
+// `start-after` applies to the first page only; continuation token (when present) takes precedence
+func TestFillLsoMsgStartAfter(t *testing.T) {
+	tests := []struct {
+		query, after, token string
+	}{
+		{"start-after=a/5", "a/5", ""},
+		{"start-after=a/5&continuation-token=a/7", "", "a/7"},
+		{"continuation-token=a/7", "", "a/7"},
+	}
+	for _, test := range tests {
+		query, err := url.ParseQuery(test.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := &apc.LsoMsg{}
+		if test.token != "" {
+			msg.StartAfter = "a/5" // e.g., left over from the first page
+		}
+		FillLsoMsg(query, msg)
+		if msg.StartAfter != test.after || msg.ContinuationToken != test.token {
+			t.Errorf("%q: expected (%q, %q), got (%q, %q)", test.query, test.after, test.token,
+				msg.StartAfter, msg.ContinuationToken)
+		}
+	}
+}
+
+func TestValidateObjName(t *testing.T) {
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{"a/b/c.txt", true},
+		{"тест/データ", true},
+		{"a\nb", false},
+		{"a\tb", false},
+		{"a\x7fb", false},
+		{"a\xffb", false},
+		{"a/b/", false},
+	}
+	for _, test := range tests {
+		if err := ValidateObjName(test.name); (err == nil) != test.ok {
+			t.Errorf("%q: expected valid=%t, got %v", test.name, test.ok, err)
+		}
+	}
+}
+
+// names that XML cannot carry fail the listing unless URL-encoded
+func TestFinalizeXMLSafe(t *testing.T) {
+	tests := []struct {
+		names []string
+		ok    bool
+	}{
+		{[]string{"a", "d\ne"}, true}, // (newline is escaped, not replaced)
+		{[]string{"a", "b\x01"}, false},
+		{[]string{"c\xff", "a"}, false},
+	}
+	for _, test := range tests {
+		for _, encoding := range []string{"", EncodingTypeURL} {
+			var (
+				r   = NewListObjectResult("bucket")
+				v   = NewListVersionsResult("bucket", url.Values{QparamEncodingType: []string{encoding}})
+				lst = &cmn.LsoResult{}
+			)
+			r.EncodingType = encoding
+			for _, name := range test.names {
+				lst.Entries = append(lst.Entries, &cmn.LsoEntry{Name: name})
+			}
+			r.FromLsoResult(lst, &apc.LsoMsg{})
+			v.FromLsoResult(lst, &apc.LsoMsg{}, false)
+			ok := test.ok || encoding == EncodingTypeURL
+			if err := r.Finalize(nil); (err == nil) != ok {
+				t.Errorf("%q, encoding %q: expected ok=%t, got %v", test.names, encoding, ok, err)
+			}
+			if err := v.Finalize(); (err == nil) != ok {
+				t.Errorf("%q, encoding %q (versions): expected ok=%t, got %v", test.names, encoding, ok, err)
+			}
+			if encoding == EncodingTypeURL && (r.Contents[1].Key != url.QueryEscape(test.names[1]) ||
+				v.Versions[1].Key != url.QueryEscape(test.names[1])) {
+				t.Errorf("%q: expected URL-encoded keys", test.names)
+			}
+		}
+	}
+}
+
+func TestEntryStorageClass(t *testing.T) {
+	var (
+		lsmsg = &apc.LsoMsg{}
+		md    = cos.StrKVs{cmn.ETag: "abc", cmn.S3StorageClassObjMD: "REDUCED_REDUNDANCY"}
+		tests = []struct {
+			custom string
+			class  string
+		}{
+			{"", StorageClassStandard},
+			{cmn.CustomMD2S(cos.StrKVs{cmn.ETag: "abc"}), StorageClassStandard},
+			{cmn.CustomMD2S(md), "REDUCED_REDUNDANCY"},
+		}
+	)
+	for _, test := range tests {
+		obj := entryToS3(&cmn.LsoEntry{Name: "o", Custom: test.custom}, lsmsg)
+		if obj.Class != test.class {
+			t.Errorf("custom %q: expected class %q, got %q", test.custom, test.class, obj.Class)
+		}
+	}
+}
+
+func TestEntryETag(t *testing.T) {
+	const xxhash = "a1b2c3d4e5f60718"
+	var (
+		lsmsg = &apc.LsoMsg{}
+		tests = []struct {
+			custom string
+			etag   string
+		}{
+			{"", xxhash}, // (no stored ETag or MD5)
+			{cmn.CustomMD2S(cos.StrKVs{cmn.ETag: "d41d8cd98f00b204e9800998ecf8427e"}), "d41d8cd98f00b204e9800998ecf8427e"},
+			{cmn.CustomMD2S(cos.StrKVs{cmn.ETag: `"0cc175b9c0f1b6a831c399e269772661-3"`}), "0cc175b9c0f1b6a831c399e269772661-3"},
+			{cmn.CustomMD2S(cos.StrKVs{cmn.MD5ObjMD: "900150983cd24fb0d6963f7d28e17f72"}), "900150983cd24fb0d6963f7d28e17f72"},
+		}
+	)
+	for _, test := range tests {
+		obj := entryToS3(&cmn.LsoEntry{Name: "o", Checksum: xxhash, Custom: test.custom}, lsmsg)
+		if obj.ETag != test.etag {
+			t.Errorf("custom %q: expected ETag %q, got %q", test.custom, test.etag, obj.ETag)
+		}
+	}
+}
+
+func TestParseContentMD5(t *testing.T) {
+	tests := []struct {
+		value, expected string
+		fail            bool
+	}{
+		{"", "", false},
+		{"1B2M2Y8AsgTpgAmY7PhCfg==", "d41d8cd98f00b204e9800998ecf8427e", false}, // md5("")
+		{"not-base64!", "", true},
+		{"YWJj", "", true}, // (3 bytes)
+	}
+	for _, test := range tests {
+		hdr := http.Header{}
+		if test.value != "" {
+			hdr.Set(HdrContentMD5, test.value)
+		}
+		v, err := ParseContentMD5(hdr)
+		if test.fail {
+			if !isErrInvalidDigest(err) {
+				t.Errorf("%q: expecting invalid-digest error, got %v", test.value, err)
+			}
+			continue
+		}
+		if err != nil || v != test.expected {
+			t.Errorf("%q: expected %q, got %q (%v)", test.value, test.expected, v, err)
+		}
+	}
+}
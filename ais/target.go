@@ -39,6 +39,7 @@ import (
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/health"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
 	"github.com/NVIDIA/aistore/reb"
@@ -314,9 +315,10 @@ func (t *target) Run() error {
 		nlog.Errorln("")
 	}
 
-	// register object type and workfile type
+	// register object, workfile, and trash content types
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+	fs.CSM.Reg(fs.TrashType, &fs.TrashContentResolver{})
 
 	// Init meta-owners and load local instances
 	if prev := t.owner.bmd.init(); prev {
@@ -359,6 +361,7 @@ func (t *target) Run() error {
 	}
 
 	t.transactions.init(t)
+	hk.Reg("trash"+hk.NameSuffix, t.hkTrash, trashHkIval)
 
 	t.reb = reb.New(config)
 	t.res = res.New()
@@ -731,9 +734,12 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 
 		// handle right here, return nil
 		if err != errSendingResp {
-			if dpq.isS3 != "" {
+			switch {
+			case dpq.isS3 != "" && goi.ecRetry:
+				s3.WriteRetryErr(w, r, err, s3.RetryAfterEC)
+			case dpq.isS3 != "":
 				s3.WriteErr(w, r, err, errCode)
-			} else {
+			default:
 				silent := dpq.silent
 				if errCode == http.StatusNotFound {
 					silent = "true"
@@ -757,7 +763,7 @@ func (t *target) _erris(w http.ResponseWriter, r *http.Request, silent string /*
 }
 
 // PUT /v1/objects/bucket-name/object-name; does:
-// 1) append object 2) append to archive 3) PUT
+// 1) append object 2) append to archive 3) copy large object in chunks (t2t) 4) PUT
 func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRequest, lom *core.LOM) {
 	var (
 		config  = cmn.GCO.Get()
@@ -801,10 +807,15 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 
 	// do
 	var (
-		handle  string
-		err     error
-		errCode int
+		handle   string
+		err      error
+		errCode  int
+		storeMD5 = cos.IsParseBool(apireq.dpq.storeMD5)
 	)
+	if storeMD5 && (apireq.dpq.archpath != "" || apireq.dpq.appendTy != "" || apireq.dpq.chunk != "") {
+		t.writeErrf(w, r, "%s: %q is not supported when appending (to objects and shards)", lom.Cname(), apc.QparamStoreMD5)
+		return
+	}
 	switch {
 	case apireq.dpq.archpath != "": // apc.QparamArchpath
 		apireq.dpq.archmime, err = archive.MimeFQN(t.smm, apireq.dpq.archmime, lom.FQN)
@@ -834,6 +845,8 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			return
 		}
 		t.statsT.IncErr(stats.AppendCount)
+	case apireq.dpq.chunk != "": // apc.QparamChunk
+		errCode, err = t.putChunk(r, lom, apireq.dpq, config, started)
 	default:
 		poi := allocPOI()
 		{
@@ -847,6 +860,7 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 			poi.lom = lom
 			poi.config = config
 			poi.skipVC = skipVC // feat.SkipVC || apc.QparamSkipVC
+			poi.storeMD5 = storeMD5
 			poi.restful = true
 			poi.t2t = t2tput
 		}
@@ -929,6 +943,24 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 		} else {
 			t.statsT.IncErr(stats.RenameCount)
 		}
+	case apc.ActRestoreObject:
+		lom = core.AllocLOM(apireq.items[1])
+		if err = lom.InitBck(apireq.bck.Bucket()); err != nil {
+			break
+		}
+		if err = t.objRestore(lom); err == nil {
+			core.FreeLOM(lom)
+			lom = nil
+		}
+	case apc.ActCopyObject:
+		lom = core.AllocLOM(apireq.items[1])
+		if err = lom.InitBck(apireq.bck.Bucket()); err != nil {
+			break
+		}
+		if err = t.objCopy(lom, apireq.query, msg); err == nil {
+			core.FreeLOM(lom)
+			lom = nil
+		}
 	case apc.ActBlobDl:
 		var (
 			xid     string
@@ -1280,6 +1312,22 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 		return http.StatusBadRequest, fmt.Errorf("failed to archive %s: missing %q in the request",
 			lom.Cname(), cos.HdrContentLength)
 	}
+	// (not counting archive headers and padding)
+	size := a.size
+	if !a.put {
+		size += lom.SizeBytes()
+	}
+	if errCode, err := checkObjSize(lom, size); err != nil {
+		return errCode, err
+	}
+	if ty, val := r.Header.Get(apc.HdrObjCksumType), r.Header.Get(apc.HdrObjCksumVal); ty != "" && val != "" {
+		if err := cos.ValidateCksumType(ty); err != nil {
+			return http.StatusBadRequest, err
+		}
+		if ty != cos.ChecksumNone {
+			a.cksum = cos.NewCksum(ty, val)
+		}
+	}
 	return a.do()
 }
 
@@ -1332,7 +1380,11 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 	}
 	if delFromAIS {
 		size := lom.SizeBytes()
-		aisErr = lom.Remove()
+		if !evict && lom.Bck().IsAIS() && !lom.ECEnabled() && cmn.GCO.Get().Space.TrashTTL > 0 {
+			aisErr = lom.MoveToTrash() // soft delete
+		} else {
+			aisErr = lom.Remove()
+		}
 		if aisErr != nil {
 			if !os.IsNotExist(aisErr) {
 				if backendErr != nil {
@@ -1356,6 +1408,18 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 	return aisErrCode, aisErr, false
 }
 
+// restore soft-deleted obj
+func (t *target) objRestore(lom *core.LOM) error {
+	ttl := cmn.GCO.Get().Space.TrashTTL.D()
+	if ttl <= 0 {
+		return fmt.Errorf("%s: cannot restore %s: soft delete is disabled (space.trash_ttl)", t.si, lom)
+	}
+	lom.Lock(true)
+	err := lom.RestoreFromTrash(ttl)
+	lom.Unlock(true)
+	return err
+}
+
 // rename obj
 func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) (err error) {
 	if lom.Bck().IsRemote() {
@@ -1395,6 +1459,46 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) (err error) {
 	return nil
 }
 
+// single-object copy (compare w/ objMv above and copyObjS3)
+func (t *target) objCopy(lom *core.LOM, query url.Values, msg *apc.ActMsg) error {
+	bckTo, err := newBckFromQuname(query, true /*required*/)
+	if err != nil {
+		return err
+	}
+	if err := bckTo.Init(t.owner.bmd); err != nil {
+		return err
+	}
+	// versioned remote source: read the latest version, whether or not
+	// the object is present (and current) in the cluster - see copyOI._reader
+	latestVer := lom.Bck().HasVersioningMD() &&
+		(cos.IsParseBool(query.Get(apc.QparamLatestVer)) || lom.VersionConf().ValidateWarmGet)
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		if !latestVer || !cos.IsNotExist(err, 0) {
+			return err
+		}
+	}
+
+	buf, slab := t.gmm.Alloc()
+	coiParams := core.AllocCOI()
+	{
+		coiParams.BckTo = bckTo
+		coiParams.ObjnameTo = cos.Either(msg.Name, lom.ObjName)
+		coiParams.Buf = buf
+		coiParams.Config = cmn.GCO.Get()
+		coiParams.OWT = cmn.OwtCopy
+		coiParams.Finalize = true
+		coiParams.LatestVer = latestVer
+	}
+	coi := (*copyOI)(coiParams)
+	_, err = coi.do(t, nil /*DM*/, lom)
+	core.FreeCOI(coiParams)
+	slab.Free(buf)
+	if err == cmn.ErrSkip {
+		err = cos.NewErrNotFound(t, lom.Cname())
+	}
+	return err
+}
+
 // compare running the same via (generic) t.xstart
 func (t *target) blobdl(lom *core.LOM, oa *cmn.ObjAttrs, args *apc.BlobMsg, w http.ResponseWriter) (string, *xs.XactBlobDl, error) {
 	// cap
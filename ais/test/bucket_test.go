@@ -21,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/tools"
 	"github.com/NVIDIA/aistore/tools/docker"
 	"github.com/NVIDIA/aistore/tools/readers"
@@ -2713,6 +2714,92 @@ func TestCopyBucketSimple(t *testing.T) {
 	t.Run("DryRun", func(t *testing.T) { f(); testCopyBucketDryRun(t, srcBck, m) })
 }
 
+// copy large objects to other targets in chunks (see 'tcb.chunk_threshold')
+func TestCopyBucketChunked(t *testing.T) {
+	tools.CheckSkip(t, &tools.SkipTestArgs{MinTargets: 2})
+	var (
+		srcBck = cmn.Bck{Name: "cpybck_src" + cos.GenTie(), Provider: apc.AIS}
+		m      = &ioContext{
+			t:         t,
+			num:       20,
+			fileSize:  3*cos.MiB + 123, // (not a multiple of the number of chunks)
+			fixedSize: true,
+			bck:       srcBck,
+		}
+		oconfig = tools.GetClusterConfig(t)
+	)
+	tools.SetClusterConfig(t, cos.StrKVs{"tcb.chunk_threshold": "1MiB"})
+	t.Cleanup(func() {
+		tools.SetClusterConfig(t, cos.StrKVs{"tcb.chunk_threshold": oconfig.TCB.ChunkThreshold.String()})
+	})
+
+	tools.CreateBucket(t, proxyURL, srcBck, nil, true /*cleanup*/)
+	m.initAndSaveState(true /*cleanup*/)
+	m.puts()
+
+	t.Run("Copy", func(t *testing.T) {
+		dstBck := cmn.Bck{Name: "cpybck_dst" + cos.GenTie(), Provider: apc.AIS}
+		xid, err := api.CopyBucket(baseParams, srcBck, dstBck, &apc.CopyBckMsg{Force: true})
+		tassert.CheckFatal(t, err)
+		t.Cleanup(func() {
+			tools.DestroyBucket(t, proxyURL, dstBck)
+		})
+		args := xact.ArgsMsg{ID: xid, Kind: apc.ActCopyBck, Timeout: 2 * time.Minute}
+		_, err = api.WaitForXactionIC(baseParams, &args)
+		tassert.CheckFatal(t, err)
+
+		list, err := api.ListObjects(baseParams, dstBck, nil, api.ListArgs{})
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, len(list.Entries) == m.num, "expected %d objects copied, got %d", m.num, len(list.Entries))
+		for _, en := range list.Entries {
+			tassert.Errorf(t, en.Size == int64(m.fileSize), "%s: expected size %d, got %d", en.Name, m.fileSize, en.Size)
+			// validate checksum
+			_, err := api.GetObjectWithValidation(baseParams, dstBck, en.Name, nil)
+			tassert.CheckError(t, err)
+		}
+	})
+
+	t.Run("Abort", func(t *testing.T) {
+		dstBck := cmn.Bck{Name: "cpybck_dst" + cos.GenTie(), Provider: apc.AIS}
+		tools.CreateBucket(t, proxyURL, dstBck, nil, true /*cleanup*/)
+
+		// slow it down to abort with chunks in flight
+		xid, err := api.CopyBucket(baseParams, srcBck, dstBck, &apc.CopyBckMsg{MaxBW: 2 * cos.MiB})
+		tassert.CheckFatal(t, err)
+		time.Sleep(3 * time.Second)
+		tlog.Logf("Aborting x-%s[%s]\n", apc.ActCopyBck, xid)
+		tassert.CheckFatal(t, api.AbortXaction(baseParams, &xact.ArgsMsg{ID: xid}))
+		time.Sleep(3 * time.Second)
+
+		// no partially copied objects
+		list, err := api.ListObjects(baseParams, dstBck, nil, api.ListArgs{})
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, len(list.Entries) < m.num, "expected copying to be aborted (copied %d)", len(list.Entries))
+		for _, en := range list.Entries {
+			tassert.Errorf(t, en.Size == int64(m.fileSize), "%s: expected size %d, got %d", en.Name, m.fileSize, en.Size)
+		}
+
+		// no leftover chunk work files
+		t.Run("Cleanup", func(t *testing.T) {
+			initMountpaths(t, proxyURL)
+			for _, mi := range fs.GetAvail() {
+				err := fs.Walk(&fs.WalkOpts{
+					Mi:  mi,
+					Bck: dstBck,
+					CTs: []string{fs.WorkfileType},
+					Callback: func(fqn string, de fs.DirEntry) error {
+						if !de.IsDir() && strings.Contains(fqn, xid) {
+							t.Errorf("leftover chunk work file %q", fqn)
+						}
+						return nil
+					},
+				})
+				tassert.CheckError(t, err)
+			}
+		})
+	})
+}
+
 func testCopyBucketStats(t *testing.T, srcBck cmn.Bck, m *ioContext) {
 	dstBck := cmn.Bck{Name: "cpybck_dst" + cos.GenTie(), Provider: apc.AIS}
 
@@ -2852,6 +2939,51 @@ func testCopyBucketDryRun(t *testing.T, srcBck cmn.Bck, m *ioContext) {
 	tassert.Errorf(t, exists == false, "expected destination bucket to not be created")
 }
 
+// copy between buckets configured with different checksum types
+// (destination checksums must be recomputed)
+func TestCopyBucketCksumType(t *testing.T) {
+	var (
+		srcBck = cmn.Bck{Name: "cpybck_src" + cos.GenTie(), Provider: apc.AIS}
+		dstBck = cmn.Bck{Name: "cpybck_dst" + cos.GenTie(), Provider: apc.AIS}
+		m      = &ioContext{
+			t:         t,
+			num:       100,
+			fileSize:  cos.KiB,
+			fixedSize: true,
+			bck:       srcBck,
+		}
+	)
+	if testing.Short() {
+		m.num /= 10
+	}
+	tools.CreateBucket(t, proxyURL, srcBck, &cmn.BpropsToSet{
+		Cksum: &cmn.CksumConfToSet{Type: apc.Ptr(cos.ChecksumXXHash)},
+	}, true /*cleanup*/)
+	tools.CreateBucket(t, proxyURL, dstBck, &cmn.BpropsToSet{
+		Cksum: &cmn.CksumConfToSet{Type: apc.Ptr(cos.ChecksumMD5)},
+	}, true /*cleanup*/)
+	m.initAndSaveState(true /*cleanup*/)
+	m.puts()
+
+	xid, err := api.CopyBucket(baseParams, srcBck, dstBck, &apc.CopyBckMsg{})
+	tassert.CheckFatal(t, err)
+	args := xact.ArgsMsg{ID: xid, Kind: apc.ActCopyBck, Timeout: time.Minute}
+	_, err = api.WaitForXactionIC(baseParams, &args)
+	tassert.CheckFatal(t, err)
+
+	list, err := api.ListObjects(baseParams, dstBck, nil, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(list.Entries) == m.num, "expected %d objects in %s, got %d", m.num, dstBck, len(list.Entries))
+	for _, en := range list.Entries {
+		props, err := api.HeadObject(baseParams, dstBck, en.Name, apc.FltPresent, false /*silent*/)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, props.Cksum.Type() == cos.ChecksumMD5, "%s: expected checksum type %q, got %q",
+			dstBck.Cname(en.Name), cos.ChecksumMD5, props.Cksum.Type())
+		_, err = api.GetObjectWithValidation(baseParams, dstBck, en.Name, nil)
+		tassert.CheckError(t, err)
+	}
+}
+
 // Tries to rename and then copy bucket at the same time.
 func TestRenameAndCopyBucket(t *testing.T) {
 	var (
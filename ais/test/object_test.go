@@ -297,6 +297,55 @@ func TestAppendObject(t *testing.T) {
 	}
 }
 
+// soft delete: rm => restore => rm => (expire) => storage cleanup => restore fails
+func TestSoftDeleteRestore(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		objName    = "trash/obj1"
+		content    = "soft-deleted content"
+		oconfig    = tools.GetClusterConfig(t)
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+	defer tools.SetClusterConfig(t, cos.StrKVs{"space.trash_ttl": oconfig.Space.TrashTTL.String()})
+	tools.SetClusterConfig(t, cos.StrKVs{"space.trash_ttl": "1m"})
+
+	_, err := api.PutObject(&api.PutArgs{
+		BaseParams: baseParams,
+		Bck:        bck,
+		ObjName:    objName,
+		Reader:     readers.NewBytes([]byte(content)),
+	})
+	tassert.CheckFatal(t, err)
+
+	// 1. rm => restore
+	tassert.CheckFatal(t, api.DeleteObject(baseParams, bck, objName))
+	_, err = api.HeadObject(baseParams, bck, objName, apc.FltPresent, true /*silent*/)
+	tassert.Fatalf(t, cmn.IsStatusNotFound(err), "expected not found, got %v", err)
+
+	tassert.CheckFatal(t, api.RestoreObject(baseParams, bck, objName))
+	writer := bytes.NewBuffer(nil)
+	_, err = api.GetObject(baseParams, bck, objName, &api.GetArgs{Writer: writer})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, writer.String() == content, "restored content %q, expected %q", writer.String(), content)
+
+	// 2. rm => expire => storage cleanup
+	tassert.CheckFatal(t, api.DeleteObject(baseParams, bck, objName))
+	tools.SetClusterConfig(t, cos.StrKVs{"space.trash_ttl": "1s"})
+	time.Sleep(2 * time.Second)
+
+	xid, err := api.StartXaction(baseParams, &xact.ArgsMsg{Kind: apc.ActStoreCleanup, Bck: bck}, "")
+	tassert.CheckFatal(t, err)
+	_, err = api.WaitForXactionIC(baseParams, &xact.ArgsMsg{ID: xid, Kind: apc.ActStoreCleanup, Timeout: time.Minute})
+	tassert.CheckFatal(t, err)
+
+	// 3. removed from trash: cannot restore even with a longer TTL
+	tools.SetClusterConfig(t, cos.StrKVs{"space.trash_ttl": "1m"})
+	err = api.RestoreObject(baseParams, bck, objName)
+	tassert.Fatalf(t, err != nil, "expected restore to fail after storage cleanup")
+}
+
 func TestSameBucketName(t *testing.T) {
 	var (
 		proxyURL   = tools.RandomProxyURL(t)
@@ -24,6 +24,8 @@ import (
 	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/tools"
 	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/tools/tlog"
+	"github.com/NVIDIA/aistore/tools/trand"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
@@ -232,3 +234,60 @@ func TestWriteThroughCacheNoColdGet(t *testing.T) {
 	})
 	tassert.Fatalf(t, err != nil, "Expected GET to fail %v", err)
 }
+
+// list a bucket via S3 API with `start-after` that falls in the middle of a (max-keys) page,
+// and then resume with continuation token across multiple pages
+func TestS3ListStartAfterContinuation(t *testing.T) {
+	var (
+		bck = cmn.Bck{Name: trand.String(10), Provider: apc.AIS}
+		m   = ioContext{
+			t:        t,
+			num:      100,
+			bck:      bck,
+			fileSize: 128,
+		}
+		pageSize = int32(10)
+	)
+	tools.CreateBucket(t, proxyURL, bck, nil, true /*cleanup*/)
+	m.init(false /*cleanup*/)
+	m.puts()
+
+	lst, err := api.ListObjects(baseParams, bck, nil, api.ListArgs{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == m.num, "expected %d objects, got %d", m.num, len(lst.Entries))
+
+	s3Client := s3.New(s3.Options{
+		BaseEndpoint: aws.String(proxyURL + "/" + apc.S3),
+		UsePathStyle: true,
+		Region:       env.AwsDefaultRegion(),
+		Credentials:  aws.AnonymousCredentials{},
+	})
+	check := func(after, token string, idx int) {
+		input := &s3.ListObjectsV2Input{Bucket: aws.String(bck.Name), MaxKeys: aws.Int32(pageSize)}
+		if after != "" {
+			input.StartAfter = aws.String(after)
+		}
+		if token != "" {
+			input.ContinuationToken = aws.String(token)
+		}
+		out, err := s3Client.ListObjectsV2(context.Background(), input)
+		tassert.CheckFatal(t, err)
+
+		expected := lst.Entries[idx+1:]
+		tassert.Fatalf(t, len(out.Contents) == len(expected), "start-after %q, token %q: expected %d objects, got %d",
+			after, token, len(expected), len(out.Contents))
+		for i, obj := range out.Contents {
+			tassert.Errorf(t, *obj.Key == expected[i].Name, "expected %q, got %q", expected[i].Name, *obj.Key)
+		}
+	}
+
+	// 1. start-after in the middle of a page
+	mid := m.num/2 + int(pageSize)/2 - 1
+	tlog.Logf("start listing %s after %q\n", bck.Cname(""), lst.Entries[mid].Name)
+	check(lst.Entries[mid].Name, "", mid)
+
+	// 2. resume: continuation token takes precedence over start-after
+	resume := m.num*3/4 + 1
+	tlog.Logf("resume listing %s from %q\n", bck.Cname(""), lst.Entries[resume].Name)
+	check(lst.Entries[mid].Name, lst.Entries[resume].Name, resume)
+}
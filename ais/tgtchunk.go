@@ -0,0 +1,336 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Copying large objects to other targets in chunks (see 'tcb.chunk_threshold'):
+// - sender: PUTs non-overlapping byte ranges of the (local, read-locked) source object
+//   in parallel, with apc.QparamChunk = <offset> and apc.HdrChunkedSize = <total size>;
+// - destination: writes each range at its offset into a shared work file named after
+//   the destination object and the xaction ID;
+// - finally, sender PUTs apc.QparamChunk = chunkDone (with object attributes and apc.HdrChunkedSize),
+//   and the destination validates size and checksum and commits the object - same as regular PUT;
+// - in case of any failure, chunkAbort removes the work file.
+// Unlike objects sent via data mover (see coi._dm), chunks are PUT over HTTP (intra-data network):
+// - the requests are canceled when the xaction aborts (or any chunk fails);
+// - each chunk's reader is throttled by the xaction's max bandwidth, if any (core.CopyParams.Throttle);
+// - no intra-cluster compression (see 'tcb.compression').
+// Destination accepts chunks only from targets (in its current cluster map) and only for
+// running xactions; each chunk must fit within the declared total size.
+// Limitations: plain copies only (no ETL), source object must be present in the cluster.
+
+const (
+	chunkDone  = "done"
+	chunkAbort = "abort"
+)
+
+func chunkWorkFQN(lom *core.LOM, xid string) string {
+	return lom.Mountpath().MakePathFQN(lom.Bucket(), fs.WorkfileType, fs.WorkfileCopy+"."+lom.ObjName+"."+xid)
+}
+
+//
+// sender
+//
+
+func (coi *copyOI) isChunked(lom *core.LOM, sargs *sendArgs) bool {
+	threshold := coi.Config.TCB.ChunkThreshold
+	return threshold > 0 && lom.SizeBytes() >= int64(threshold) && coi.Xact != nil && sargs.owt == cmn.OwtCopy
+}
+
+// is called with the source `lom` loaded and read-locked; unlocks it upon return
+func (coi *copyOI) _chunked(t *target, lom *core.LOM, sargs *sendArgs) (size int64, _ error) {
+	defer func() {
+		lom.Unlock(false)
+		if sargs.dm != nil {
+			core.FreeLOM(lom) // (cloned - see _send)
+		}
+	}()
+	fh, err := os.Open(lom.FQN)
+	if err != nil {
+		return 0, cmn.NewErrFailedTo(t, "open", lom.FQN, err)
+	}
+	defer cos.Close(fh)
+
+	var (
+		wg     sync.WaitGroup
+		oa     cos.OAH = lom
+		n              = int64(coi.Config.TCB.ChunkWorkers)
+		stopCh         = make(chan struct{})
+	)
+	if n == 0 {
+		n = apc.DfltTCBChunkWorkers
+	}
+	// cancel all in-flight chunks upon xaction abort or the first failure
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-coi.Xact.ChanAbort():
+			cancel()
+		case <-stopCh:
+		}
+	}()
+	defer close(stopCh)
+
+	errs := make([]error, n)
+	size = lom.SizeBytes()
+	ssize := strconv.FormatInt(size, 10)
+	chunk := (size + n - 1) / n
+	for i, off := 0, int64(0); off < size; i, off = i+1, off+chunk {
+		wg.Add(1)
+		go func(i int, off int64) {
+			cr := &chunkReader{r: io.NewSectionReader(fh, off, min(chunk, size-off)), throttle: coi.Throttle}
+			hdr := make(http.Header, 2)
+			hdr.Set(apc.HdrChunkedSize, ssize)
+			if errs[i] = coi._putChunk(ctx, t, sargs, cr, strconv.FormatInt(off, 10), hdr); errs[i] != nil {
+				cancel()
+			}
+			wg.Done()
+		}(i, off)
+	}
+	wg.Wait()
+
+	if err = errors.Join(errs...); err == nil {
+		if coi.CustomMD != nil {
+			oah := &cmn.ObjAttrs{}
+			oah.CopyFrom(lom, false /*skip cksum*/)
+			oah.SetCustomMD(coi.CustomMD)
+			oa = oah
+		}
+		hdr := make(http.Header, 8)
+		cmn.ToHeader(oa, hdr)
+		hdr.Del(cos.HdrContentLength)
+		hdr.Set(apc.HdrChunkedSize, ssize)
+		err = coi._putChunk(ctx, t, sargs, nil, chunkDone, hdr)
+	}
+	if err != nil {
+		// (not canceling)
+		if erra := coi._putChunk(context.Background(), t, sargs, nil, chunkAbort, nil); erra != nil {
+			nlog.Warningln(t.String(), "failed to abort chunked copy of", lom.Cname(), "[", erra, "]")
+		}
+		if errAborted := coi.Xact.AbortErr(); errAborted != nil {
+			err = errAborted
+		}
+		return 0, err
+	}
+	coi.Xact.OutObjsAdd(1, size) // (compare w/ data mover)
+	return size, nil
+}
+
+// chunk reader: throttles (max bandwidth) prior to reading
+type chunkReader struct {
+	r        *io.SectionReader
+	throttle func(int64)
+	off      int64
+}
+
+func (cr *chunkReader) Read(p []byte) (int, error) {
+	if cr.throttle != nil {
+		if left := cr.r.Size() - cr.off; int64(len(p)) > left {
+			p = p[:left]
+		}
+		if len(p) > 0 {
+			cr.throttle(int64(len(p)))
+		}
+	}
+	n, err := cr.r.Read(p)
+	cr.off += int64(n)
+	return n, err
+}
+
+// (compare with coi.put)
+func (coi *copyOI) _putChunk(ctx context.Context, t *target, sargs *sendArgs, cr *chunkReader, chunk string, hdr http.Header) error {
+	var (
+		query = sargs.bckTo.NewQuery()
+		body  io.Reader
+	)
+	if hdr == nil {
+		hdr = make(http.Header, 2)
+	}
+	hdr.Set(apc.HdrT2TPutterID, t.SID())
+	query.Set(apc.QparamOWT, sargs.owt.ToS())
+	query.Set(apc.QparamUUID, coi.Xact.ID())
+	query.Set(apc.QparamChunk, chunk)
+	if cr != nil {
+		body = cr
+	}
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodPut,
+		Base:   sargs.tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathObjects.Join(sargs.bckTo.Name, sargs.objNameTo),
+		Query:  query,
+		Header: hdr,
+		BodyR:  body,
+	}
+	req, err := reqArgs.Req()
+	if err != nil {
+		return fmt.Errorf("unexpected failure to create request, err: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, coi.Config.Timeout.SendFile.D())
+	defer cancel()
+	req = req.WithContext(ctx)
+	if cr != nil {
+		req.ContentLength = cr.r.Size()
+	}
+	resp, err := g.client.data.Do(req)
+	if err != nil {
+		return cmn.NewErrFailedTo(t, "coi.put-chunk "+sargs.bckTo.Name+"/"+sargs.objNameTo, sargs.tsi, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, cos.KiB))
+		return fmt.Errorf("%s: chunk %q of %s => %s failed: %s (status %d)", t, chunk,
+			sargs.bckTo.Cname(sargs.objNameTo), sargs.tsi, b, resp.StatusCode)
+	}
+	cos.DrainReader(resp.Body)
+	return nil
+}
+
+//
+// destination
+//
+
+func (t *target) putChunk(r *http.Request, lom *core.LOM, dpq *dpq, config *cmn.Config, started int64) (int, error) {
+	if errCode, err := t._authChunk(r, lom, dpq); err != nil {
+		return errCode, err
+	}
+	workFQN := chunkWorkFQN(lom, dpq.uuid)
+	switch dpq.chunk {
+	case chunkDone:
+		return t._finiChunks(r, lom, workFQN, dpq, config, started)
+	case chunkAbort:
+		return 0, cos.RemoveFile(workFQN)
+	}
+
+	off, err := strconv.ParseInt(dpq.chunk, 10, 64)
+	if err != nil || off < 0 {
+		return http.StatusBadRequest, fmt.Errorf("%s: invalid chunk offset %q (%s)", t, dpq.chunk, lom.Cname())
+	}
+	size, err := strconv.ParseInt(r.Header.Get(apc.HdrChunkedSize), 10, 64)
+	if err != nil || size < 0 {
+		return http.StatusBadRequest, fmt.Errorf("%s: invalid %s %q (%s)", t, apc.HdrChunkedSize,
+			r.Header.Get(apc.HdrChunkedSize), lom.Cname())
+	}
+	if r.ContentLength < 0 || off+r.ContentLength > size {
+		return http.StatusBadRequest, fmt.Errorf("%s: chunk [%d, +%d) is out of bounds (%s, size %d)",
+			t, off, r.ContentLength, lom.Cname(), size)
+	}
+	if err := cos.CreateDir(filepath.Dir(workFQN)); err != nil {
+		return 0, err
+	}
+	fh, err := os.OpenFile(workFQN, os.O_WRONLY|os.O_CREATE, cos.PermRWR) // (concurrent writers - no truncation)
+	if err != nil {
+		return 0, err
+	}
+	buf, slab := t.gmm.Alloc()
+	n, err := io.CopyBuffer(io.NewOffsetWriter(fh, off), io.LimitReader(r.Body, r.ContentLength), buf)
+	slab.Free(buf)
+	if errc := fh.Close(); err == nil {
+		err = errc
+	}
+	if err == nil && n != r.ContentLength {
+		err = fmt.Errorf("%s: chunk at offset %d of %s: wrote %d bytes, expected %d", t, off, lom.Cname(), n, r.ContentLength)
+	}
+	return 0, err
+}
+
+// chunks are accepted only from other targets in the cluster map, and only for the xactions
+// that are running (abort: that exist) on this target
+func (t *target) _authChunk(r *http.Request, lom *core.LOM, dpq *dpq) (int, error) {
+	if !isT2TPut(r.Header) || dpq.uuid == "" {
+		return http.StatusBadRequest, fmt.Errorf("%s: invalid chunked PUT %s (expecting intra-cluster copy with xaction ID)",
+			t, lom.Cname())
+	}
+	var (
+		smap = t.owner.smap.get()
+		sid  = r.Header.Get(apc.HdrT2TPutterID)
+	)
+	if tsi := smap.GetTarget(sid); tsi == nil || sid == t.SID() {
+		return http.StatusForbidden, fmt.Errorf("%s: chunked PUT %s from unknown target %q (%s)", t, lom.Cname(), sid, smap)
+	}
+	xctn, err := xreg.GetXact(dpq.uuid)
+	switch {
+	case err != nil:
+		return http.StatusBadRequest, err
+	case xctn == nil:
+		return http.StatusNotFound, fmt.Errorf("%s: chunked PUT %s: xaction %q not found", t, lom.Cname(), dpq.uuid)
+	case dpq.chunk != chunkAbort && !xctn.Running():
+		return http.StatusGone, fmt.Errorf("%s: chunked PUT %s: %s is not running", t, lom.Cname(), xctn)
+	}
+	return 0, nil
+}
+
+// validate and commit (compare with poi.do and poi.putObject)
+func (t *target) _finiChunks(r *http.Request, lom *core.LOM, workFQN string, dpq *dpq, config *cmn.Config, started int64) (int, error) {
+	size, err := strconv.ParseInt(r.Header.Get(apc.HdrChunkedSize), 10, 64)
+	if err != nil {
+		return http.StatusBadRequest, fmt.Errorf("%s: invalid %s %q (%s)", t, apc.HdrChunkedSize,
+			r.Header.Get(apc.HdrChunkedSize), lom.Cname())
+	}
+	finfo, err := os.Stat(workFQN)
+	if err != nil {
+		return 0, err
+	}
+	if finfo.Size() != size {
+		cos.RemoveFile(workFQN)
+		return http.StatusBadRequest, fmt.Errorf("%s: chunked copy of %s: size mismatch (%d vs expected %d)",
+			t, lom.Cname(), finfo.Size(), size)
+	}
+
+	cksum := lom.ObjAttrs().FromHeader(r.Header)
+	lom.SetSize(size)
+	if ty := lom.CksumType(); ty != cos.ChecksumNone {
+		fh, err := os.Open(workFQN)
+		if err != nil {
+			return 0, err
+		}
+		_, cksumH, err := cos.CopyAndChecksum(io.Discard, fh, nil, ty)
+		cos.Close(fh)
+		if err != nil {
+			return 0, err
+		}
+		if !cksum.IsEmpty() && cksum.Ty() == ty && !cksumH.Equal(cksum) {
+			cos.RemoveFile(workFQN)
+			return http.StatusBadRequest, cos.NewErrDataCksum(&cksumH.Cksum, cksum, lom.Cname())
+		}
+		lom.SetCksum(cksumH.Clone())
+	}
+
+	poi := allocPOI()
+	{
+		poi.t = t
+		poi.lom = lom
+		poi.config = config
+		poi.workFQN = workFQN
+		poi.atime = started
+		poi.t2t = true
+		poi.owt.FromS(dpq.owt)
+	}
+	if xctn, err := xreg.GetXact(dpq.uuid); err == nil && xctn != nil {
+		poi.xctn = xctn
+	}
+	errCode, err := poi.finalize()
+	freePOI(poi)
+	return errCode, err
+}
@@ -119,6 +119,7 @@ func (goi *getOI) coldSeek(res *core.GetReaderResult) error {
 	cmn.ToHeader(lom.ObjAttrs(), whdr)
 	if goi.isS3 {
 		s3.SetEtag(whdr, goi.lom)
+		s3.SetStdHeaders(whdr, lom.GetCustomMD())
 	}
 
 	written, err = cos.CopyBuffer(goi.w, reader, buf)
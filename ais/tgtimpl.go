@@ -150,6 +150,10 @@ func (t *target) CopyObject(lom *core.LOM, dm core.DM, params *core.CopyParams)
 	debug.Assert(ok)
 
 	size, err = coi.do(t, realDM, lom)
+	if cb := coi.OnSent; cb != nil { // (not handed over to data mover)
+		coi.OnSent = nil
+		cb(err)
+	}
 
 	coi.stats(size, err)
 	return size, err
@@ -289,7 +293,7 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 
 		fileSize = fi.Size()
 		workFQN = params.SrcFQN
-		if params.Cksum != nil {
+		if params.Cksum != nil && !params.ComputeCksum {
 			lom.SetCksum(params.Cksum) // already computed somewhere else, use it
 		} else {
 			clone := lom.CloneMD(params.SrcFQN)
@@ -310,6 +314,11 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 			return
 		}
 	}
+	if params.ComputeCksum {
+		if err = promETag(lom, workFQN); err != nil {
+			return
+		}
+	}
 	poi := allocPOI()
 	{
 		poi.atime = time.Now().UnixNano()
@@ -326,6 +335,21 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 	return
 }
 
+// S3 ETag, unless the bucket is configured to checksum with MD5 in the first place
+func promETag(lom *core.LOM, fqn string) error {
+	if lom.CksumType() == cos.ChecksumMD5 {
+		return nil
+	}
+	clone := lom.CloneMD(fqn)
+	md5h, err := clone.ComputeCksum(cos.ChecksumMD5)
+	core.FreeLOM(clone)
+	if err != nil {
+		return err
+	}
+	lom.SetCustomKey(cmn.ETag, md5h.Value())
+	return nil
+}
+
 // TODO: use DM streams
 // TODO: Xact.InObjsAdd on the receive side
 func (t *target) _promRemote(params *core.PromoteParams, lom *core.LOM, tsi *meta.Snode, smap *smapX) (int64, error) {
@@ -335,6 +359,15 @@ func (t *target) _promRemote(params *core.PromoteParams, lom *core.LOM, tsi *met
 	if !params.OverwriteDst && t.headt2t(lom, tsi, smap) {
 		return -1, nil
 	}
+	// the checksum and ETag travel with the object (see coi.put => cmn.ToHeader)
+	if params.ComputeCksum {
+		if _, err := lom.ComputeSetCksum(); err != nil {
+			return 0, err
+		}
+		if err := promETag(lom, lom.FQN); err != nil {
+			return 0, err
+		}
+	}
 
 	coiParams := core.AllocCOI()
 	{
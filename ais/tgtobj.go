@@ -59,12 +59,18 @@ type (
 		atime      int64         // access time.Now()
 		ltime      int64         // mono.NanoTime, to measure latency
 		size       int64         // aka Content-Length
+		maxSize    int64         // user PUT: max object size (bucket property); zero: unlimited
 		owt        cmn.OWT       // object write transaction enum { OwtPut, ..., OwtGet* }
 		restful    bool          // being invoked via RESTful API
 		t2t        bool          // by another target
 		skipEC     bool          // do not erasure-encode when finalizing
+		skipMirror bool          // do not make local copies when finalizing (see also skipEC)
 		skipVC     bool          // skip loading existing Version and skip comparing Checksums (skip VC)
 		coldGET    bool          // (one implication: proceed to write)
+		etagMD5    bool          // S3 PUT: compute md5 and store it as ETag (feat.S3ETagMD5)
+		storeMD5   bool          // compute md5 and store it as custom metadata (apc.QparamStoreMD5)
+		contentMD5 string        // S3 PUT: expected md5 (hex) as per `Content-MD5`, to validate received bytes
+		cond       *s3.PutCond   // S3 conditional PUT (If-Match, et al.)
 	}
 
 	getOI struct {
@@ -85,6 +91,7 @@ type (
 		cold       bool            // true if executed backend.Get
 		latestVer  bool            // QparamLatestVer || 'versioning.*_warm_get'
 		isS3       bool            // calling via /s3 API
+		ecRetry    bool            // failed to EC-recover, transiently (S3 clients are asked to retry - see ecRetriable)
 	}
 
 	// textbook append: (packed) handle and control structure (see also `putA2I` arch below)
@@ -126,7 +133,9 @@ type (
 		mime     string        // format
 		started  int64         // time of receiving
 		size     int64         // aka Content-Length
-		put      bool          // overwrite
+		cksum    *cos.Cksum    // (optional) archived file's checksum to validate and store (TAR formats)
+		cksumH   *cos.CksumHash
+		put      bool // overwrite
 	}
 )
 
@@ -163,9 +172,24 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 			poi.size = size
 		}
 	}
+	if poi.restful && !poi.t2t {
+		if errCode, err := checkObjSize(poi.lom, poi.size); err != nil {
+			return errCode, err
+		}
+		poi.maxSize = int64(poi.lom.Bprops().MaxObjSize) // (when Content-Length is unknown)
+	}
 	return poi.putObject()
 }
 
+// user PUT and APPEND: fail fast when the (resulting) size exceeds max object size
+// (bucket property inherited from the cluster config - see cmn.PropBucketMaxObjSize)
+func checkObjSize(lom *core.LOM, size int64) (int, error) {
+	if limit := int64(lom.Bprops().MaxObjSize); limit > 0 && size > limit {
+		return http.StatusRequestEntityTooLarge, cmn.NewErrObjTooLarge(lom.Cname(), size, limit)
+	}
+	return 0, nil
+}
+
 func (poi *putOI) putObject() (errCode int, err error) {
 	poi.ltime = mono.NanoTime()
 	// PUT is a no-op if the checksums do match
@@ -183,6 +207,12 @@ func (poi *putOI) putObject() (errCode int, err error) {
 	poi._cleanup(buf, slab, lmfh, erw)
 	if erw != nil {
 		err, errCode = erw, http.StatusInternalServerError
+		switch {
+		case s3.IsErrBadDigest(erw):
+			errCode = http.StatusBadRequest
+		case cmn.IsErrObjTooLarge(erw):
+			errCode = http.StatusRequestEntityTooLarge
+		}
 		goto rerr
 	}
 
@@ -270,7 +300,9 @@ func (poi *putOI) finalize() (errCode int, err error) {
 			return errCode, err
 		}
 	}
-	poi.t.putMirror(poi.lom)
+	if !poi.skipMirror {
+		poi.t.putMirror(poi.lom)
+	}
 	return 0, nil
 }
 
@@ -279,7 +311,18 @@ func (poi *putOI) fini() (errCode int, err error) {
 	var (
 		lom = poi.lom
 		bck = lom.Bck()
+		// S3 conditional PUT into a remote bucket: evaluate under write lock prior to writing remote
+		condRemote = poi.cond != nil && bck.IsRemote() && poi.owt == cmn.OwtPut
 	)
+	if condRemote {
+		debug.Assert(cos.IsValidAtime(poi.atime), poi.atime)
+		lom.Lock(true)
+		defer lom.Unlock(true)
+		if errCode, err = checkPutCond(lom, poi.cond, true /*locked*/); err != nil {
+			return errCode, err
+		}
+	}
+
 	// put remote
 	if bck.IsRemote() && poi.owt < cmn.OwtRebalance {
 		errCode, err = poi.putRemote()
@@ -315,8 +358,15 @@ func (poi *putOI) fini() (errCode int, err error) {
 	default:
 		// expecting valid atime passed with `poi`
 		debug.Assert(cos.IsValidAtime(poi.atime), poi.atime)
-		lom.Lock(true)
-		defer lom.Unlock(true)
+		if !condRemote { // (otherwise, already locked and checked - see above)
+			lom.Lock(true)
+			defer lom.Unlock(true)
+			if poi.cond != nil {
+				if errCode, err = checkPutCond(lom, poi.cond, true /*locked*/); err != nil {
+					return errCode, err
+				}
+			}
+		}
 		lom.SetAtimeUnix(poi.atime)
 	}
 
@@ -361,6 +411,10 @@ func (poi *putOI) putRemote() (errCode int, err error) {
 		err = cmn.NewErrFailedTo(poi.t, "open", poi.workFQN, err)
 		return
 	}
+	var md5 string
+	if poi.storeMD5 {
+		md5, _ = lom.GetCustomKey(cmn.MD5ObjMD) // computed while writing
+	}
 	if poi.owt == cmn.OwtPut && !lom.Bck().IsRemoteAIS() {
 		// some/all of those are set by the backend.PutObj()
 		lom.ObjAttrs().DelCustomKeys(cmn.SourceObjMD, cmn.CRC32CObjMD, cmn.ETag, cmn.MD5ObjMD, cmn.VersionObjMD)
@@ -370,6 +424,9 @@ func (poi *putOI) putRemote() (errCode int, err error) {
 	if err == nil && !lom.Bck().IsRemoteAIS() {
 		lom.SetCustomKey(cmn.SourceObjMD, backend.Provider())
 	}
+	if err == nil && md5 != "" {
+		lom.SetCustomKey(cmn.MD5ObjMD, md5)
+	}
 	return
 }
 
@@ -385,10 +442,21 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh *os.File, err err
 			finalized bool           // to avoid computing the same checksum type twice
 		}{}
 		ckconf = poi.lom.CksumConf()
+		md5h   *cos.CksumHash // S3 ETag and/or Content-MD5
+		lw     io.Writer
+		r      io.Reader = poi.r
 	)
+	if poi.maxSize > 0 {
+		r = io.LimitReader(poi.r, poi.maxSize+1)
+	}
 	if lmfh, err = poi.lom.CreateFile(poi.workFQN); err != nil {
 		return
 	}
+	lw = lmfh
+	if (poi.etagMD5 && ckconf.Type != cos.ChecksumMD5) || poi.contentMD5 != "" || poi.storeMD5 {
+		md5h = cos.NewCksumHash(cos.ChecksumMD5)
+		lw = cos.NewWriterMulti(md5h.H, lmfh)
+	}
 	if poi.size <= 0 {
 		buf, slab = poi.t.gmm.Alloc()
 	} else {
@@ -400,13 +468,15 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh *os.File, err err
 		poi.lom.SetCksum(cos.NoneCksum)
 		// not using `ReadFrom` of the `*os.File` -
 		// ultimately, https://github.com/golang/go/blob/master/src/internal/poll/copy_file_range_linux.go#L100
-		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
-	case !poi.cksumToUse.IsEmpty() && !poi.validateCksum(ckconf):
+		written, err = cos.CopyBuffer(lw, r, buf)
+	case !poi.cksumToUse.IsEmpty() && !poi.validateCksum(ckconf) && poi.cksumToUse.Ty() == ckconf.Type:
 		// if the corresponding validation is not configured/enabled we just go ahead
 		// and use the checksum that has arrived with the object
+		// (unless it is of a different type - e.g., when copying between buckets
+		// configured with different checksums)
 		poi.lom.SetCksum(poi.cksumToUse)
 		// (ditto)
-		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
+		written, err = cos.CopyBuffer(lw, r, buf)
 	default:
 		writers := make([]io.Writer, 0, 3)
 		cksums.store = cos.NewCksumHash(ckconf.Type) // always according to the bucket
@@ -421,14 +491,29 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh *os.File, err err
 				writers = append(writers, cksums.compt.H)
 			}
 		}
-		writers = append(writers, lmfh)
-		written, err = cos.CopyBuffer(cos.NewWriterMulti(writers...), poi.r, buf) // (ditto)
+		writers = append(writers, lw)
+		written, err = cos.CopyBuffer(cos.NewWriterMulti(writers...), r, buf) // (ditto)
 	}
 	if err != nil {
 		return
 	}
+	if poi.maxSize > 0 && written > poi.maxSize {
+		err = cmn.NewErrObjTooLarge(poi.lom.Cname(), written, poi.maxSize)
+		return
+	}
 
 	// validate
+	if md5h != nil {
+		md5h.Finalize()
+		if poi.contentMD5 != "" && md5h.Value() != poi.contentMD5 {
+			err = s3.NewErrBadDigest(poi.contentMD5, md5h.Value())
+			poi.t.statsT.AddMany(
+				cos.NamedVal64{Name: stats.ErrCksumCount, Value: 1},
+				cos.NamedVal64{Name: stats.ErrCksumSize, Value: written},
+			)
+			return
+		}
+	}
 	if cksums.compt != nil {
 		cksums.finalized = cksums.compt == cksums.store
 		cksums.compt.Finalize()
@@ -458,6 +543,12 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh *os.File, err err
 		}
 		poi.lom.SetCksum(&cksums.store.Cksum)
 	}
+	if md5h != nil && poi.etagMD5 {
+		poi.lom.SetCustomKey(cmn.ETag, md5h.Value())
+	}
+	if md5h != nil && poi.storeMD5 {
+		poi.lom.SetCustomKey(cmn.MD5ObjMD, md5h.Value())
+	}
 	return
 }
 
@@ -897,11 +988,12 @@ gfn:
 			return
 		}
 		err = cmn.NewErrFailedTo(goi.t, "load EC-recovered", goi.lom, ecErr)
-	} else if ecErr != ec.ErrorECDisabled {
+	} else if ecErr != ec.ErrorECDisabled && ecErr != ec.ErrorNoMetafile {
 		err = cmn.NewErrFailedTo(goi.t, "EC-recover", goi.lom, ecErr)
 		if cmn.IsErrCapExceeded(ecErr) {
 			errCode = http.StatusInsufficientStorage
 		}
+		goi.ecRetry = ecRetriable(ecErr, enoughECRestoreTargets, running)
 		return
 	}
 
@@ -914,6 +1006,16 @@ gfn:
 	return
 }
 
+// the object exists (there's EC metadata) but cannot be reconstructed right now - retry only
+// when the condition is transient: not enough targets (e.g., restarting) or rebalance in progress;
+// in particular, "too many slices missing" with all targets present is not
+func ecRetriable(ecErr error, enoughECRestoreTargets, rebRunning bool) bool {
+	if cmn.IsErrCapExceeded(ecErr) {
+		return false
+	}
+	return !enoughECRestoreTargets || rebRunning
+}
+
 func (goi *getOI) getFromNeighbor(lom *core.LOM, tsi *meta.Snode) bool {
 	query := lom.Bck().NewQuery()
 	query.Set(apc.QparamIsGFNRequest, "true")
@@ -1052,6 +1154,11 @@ func (goi *getOI) fini(fqn string, lmfh *os.File, hdr http.Header, hrng *htrange
 		reader, size = csl, csl.Size()
 		hdr.Del(apc.HdrObjCksumVal)
 		hdr.Del(apc.HdrObjCksumType)
+		if cksum := archive.MemberCksum(csl); cksum != nil {
+			// stored at archiving time (TAR formats only)
+			hdr.Set(apc.HdrObjCksumType, cksum.Ty())
+			hdr.Set(apc.HdrObjCksumVal, cksum.Val())
+		}
 		hdr.Set(apc.HdrArchmime, mime)
 		hdr.Set(apc.HdrArchpath, goi.archive.filename)
 	case hrng != nil: // range
@@ -1082,6 +1189,9 @@ func (goi *getOI) fini(fqn string, lmfh *os.File, hdr http.Header, hrng *htrange
 
 	hdr.Set(cos.HdrContentLength, strconv.FormatInt(size, 10))
 	hdr.Set(cos.HdrContentType, cos.ContentBinary)
+	if goi.isS3 && goi.archive.filename == "" {
+		s3.SetStdHeaders(hdr, goi.lom.GetCustomMD())
+	}
 
 	buf, slab := goi.t.gmm.AllocSize(min(size, 64*cos.KiB))
 	err = goi.transmit(reader, buf, fqn)
@@ -1234,13 +1344,41 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, errCode int, err error) {
 		return
 	}
 
+	var (
+		r       io.Reader = a.r
+		cur     int64
+		written int64
+		limit   = int64(a.lom.Bprops().MaxObjSize)
+	)
+	if limit > 0 {
+		finfo, ers := fh.Stat()
+		if ers != nil {
+			cos.Close(fh)
+			return "", http.StatusInternalServerError, ers
+		}
+		cur = finfo.Size()
+		if a.size > 0 {
+			if errCode, err = checkObjSize(a.lom, cur+a.size); err != nil {
+				cos.Close(fh)
+				return
+			}
+		}
+		r = io.LimitReader(a.r, limit-cur+1)
+	}
 	w := cos.NewWriterMulti(fh, a.hdl.partialCksum.H)
-	_, err = cos.CopyBuffer(w, a.r, buf)
+	written, err = cos.CopyBuffer(w, r, buf)
 	cos.Close(fh)
 	if err != nil {
 		errCode = http.StatusInternalServerError
 		return
 	}
+	if limit > 0 && cur+written > limit {
+		// (partially appended - cannot be flushed)
+		if errRm := cos.RemoveFile(workFQN); errRm != nil {
+			nlog.Errorln(errRm)
+		}
+		return "", http.StatusRequestEntityTooLarge, cmn.NewErrObjTooLarge(a.lom.Cname(), cur+written, limit)
+	}
 
 	packedHdl = a.pack(workFQN)
 
@@ -1339,6 +1477,7 @@ func (coi *copyOI) do(t *target, dm *bundle.DataMover, lom *core.LOM) (size int6
 	}
 
 	// dst is this target
+	coi.throttle(lom.SizeBytes())
 	// 2, 3: with transformation and without
 	dst := core.AllocLOM(coi.ObjnameTo)
 	if err := dst.InitBck(coi.BckTo.Bucket()); err != nil {
@@ -1411,11 +1550,17 @@ func (coi *copyOI) _reader(t *target, dm *bundle.DataMover, lom, dst *core.LOM)
 	if dm != nil {
 		poi.owt = dm.OWT() // (compare with _send)
 	}
+	if coi.CustomMD != nil {
+		dst.SetCustomMD(coi.CustomMD) // (compare with _regular)
+	}
 	errCode, err := poi.putObject()
 	freePOI(poi)
 	if err == nil {
 		// xaction stats: inc locally processed (and see data mover for in and out objs)
 		size = oah.SizeBytes()
+		if size < 0 { // post-transform size was not known upfront (e.g., transcoding)
+			size = dst.SizeBytes()
+		}
 	}
 	return size, errCode, err
 }
@@ -1448,6 +1593,13 @@ func (coi *copyOI) _regular(t *target, lom, dst *core.LOM) (size int64, _ error)
 		}
 	}
 	dst2, err := lom.Copy2FQN(dst.FQN, coi.Buf)
+	if err == nil {
+		err = recksum(dst2)
+	}
+	if err == nil && coi.CustomMD != nil {
+		dst2.SetCustomMD(coi.CustomMD)
+		err = dst2.Persist()
+	}
 	if err == nil {
 		size = lom.SizeBytes()
 		if coi.Finalize {
@@ -1460,6 +1612,19 @@ func (coi *copyOI) _regular(t *target, lom, dst *core.LOM) (size int64, _ error)
 	return size, err
 }
 
+// the copy carries the source checksum; recompute it if the destination bucket
+// is configured with a different checksum type
+func recksum(dst *core.LOM) error {
+	ty := dst.CksumType()
+	if ty == cos.ChecksumNone || dst.Checksum().Type() == ty {
+		return nil
+	}
+	if _, err := dst.ComputeSetCksum(); err != nil {
+		return err
+	}
+	return dst.Persist()
+}
+
 // send object => designated target
 // * source is a LOM or a reader (that may be reading from remote)
 // * one of the two equivalent transmission mechanisms: PUT or transport Send
@@ -1507,30 +1672,50 @@ func (coi *copyOI) _send(t *target, lom *core.LOM, sargs *sendArgs) (size int64,
 		}
 		size = fi.Size()
 		sargs.reader, sargs.objAttrs = fh, lom
+		coi.throttle(size)
 	case coi.DP == nil:
 		// 2. migrate/replicate lom
-
+		chunked := coi.isChunked(lom, sargs)
+		if !chunked {
+			coi.throttle(lom.SizeBytes()) // (chunks are throttled individually)
+		}
 		lom.Lock(false)
 		if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
 			lom.Unlock(false)
 			return 0, nil
 		}
+		if chunked {
+			return coi._chunked(t, lom, sargs) // (unlocks)
+		}
 		reader, err := lom.NewDeferROC()
 		if err != nil {
 			return 0, err
 		}
 		size = lom.SizeBytes()
 		sargs.reader, sargs.objAttrs = reader, lom
+		if coi.CustomMD != nil {
+			oa := &cmn.ObjAttrs{}
+			oa.CopyFrom(lom, false /*skip cksum*/)
+			oa.SetCustomMD(coi.CustomMD)
+			sargs.objAttrs = oa
+		}
 	default:
 		// 3. DP transform (possibly, no-op)
 		// If the object is not present call t.Backend.GetObjReader
+		coi.throttle(lom.SizeBytes())
 		reader, oah, err := coi.DP.Reader(lom, coi.LatestVer, coi.Sync)
 		if err != nil {
-			return
+			return 0, err
 		}
 		// returns cos.ContentLengthUnknown (-1) if post-transform size is unknown
 		size = oah.SizeBytes()
 		sargs.reader, sargs.objAttrs = reader, oah
+		if coi.CustomMD != nil {
+			oa := &cmn.ObjAttrs{}
+			oa.CopyFrom(oah, false /*skip cksum*/)
+			oa.SetCustomMD(coi.CustomMD)
+			sargs.objAttrs = oa
+		}
 	}
 
 	// do
@@ -1556,7 +1741,12 @@ func (coi *copyOI) _dm(lom *core.LOM, sargs *sendArgs) error {
 		hdr.ObjName = sargs.objNameTo
 		hdr.ObjAttrs.CopyFrom(oa, false /*skip cksum*/)
 	}
-	o.Callback = func(_ *transport.ObjHdr, _ io.ReadCloser, _ any, _ error) {
+	sent := coi.OnSent
+	coi.OnSent = nil // the callback below is always invoked, including on error
+	o.Callback = func(_ *transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
+		if sent != nil {
+			sent(err)
+		}
 		core.FreeLOM(lom)
 	}
 	return sargs.dm.Send(o, sargs.reader, sargs.tsi)
@@ -1598,6 +1788,13 @@ func (coi *copyOI) put(t *target, sargs *sendArgs) error {
 	return nil
 }
 
+// (see core.CopyParams.Throttle)
+func (coi *copyOI) throttle(size int64) {
+	if coi.Throttle != nil && size > 0 {
+		coi.Throttle(size)
+	}
+}
+
 func (coi *copyOI) stats(size int64, err error) {
 	if err == nil && coi.Xact != nil {
 		coi.Xact.ObjsAdd(1, size)
@@ -1612,6 +1809,10 @@ func (a *putA2I) do() (int, error) {
 	if a.filename == "" {
 		return 0, errors.New("archive path is not defined")
 	}
+	if a.cksum != nil {
+		a.cksumH = cos.NewCksumHash(a.cksum.Ty())
+		a.r = io.NopCloser(io.TeeReader(a.r, a.cksumH.H))
+	}
 	// standard library does not support appending to tgz, zip, and such;
 	// for TAR there is an optimizing workaround not requiring a full copy
 	if a.mime == archive.ExtTar && !a.put {
@@ -1664,10 +1865,11 @@ cpap: // copy + append
 	}
 	// currently, arch writers only use size and time but it may change
 	oah := cos.SimpleOAH{Size: a.size, Atime: a.started}
+	opts := a.opts()
 	if a.put {
 		// when append becomes PUT (TODO: checksum type)
 		cksum.Init(cos.ChecksumXXHash)
-		aw = archive.NewWriter(a.mime, wfh, &cksum, nil /*opts*/)
+		aw = archive.NewWriter(a.mime, wfh, &cksum, opts)
 		err = aw.Write(a.filename, oah, a.r)
 		aw.Fini()
 	} else {
@@ -1678,7 +1880,7 @@ cpap: // copy + append
 			return http.StatusNotFound, err
 		}
 		cksum.Init(a.lom.CksumType())
-		aw = archive.NewWriter(a.mime, wfh, &cksum, nil)
+		aw = archive.NewWriter(a.mime, wfh, &cksum, opts)
 		err = aw.Copy(lmfh, a.lom.SizeBytes())
 		if err == nil {
 			err = aw.Write(a.filename, oah, a.r)
@@ -1689,6 +1891,9 @@ cpap: // copy + append
 
 	// finalize
 	cos.Close(wfh)
+	if err == nil {
+		err = a.validate()
+	}
 	if err == nil {
 		cksum.Finalize()
 		err = a.finalize(cksum.Size, cksum.Clone(), workFQN)
@@ -1711,10 +1916,21 @@ func (a *putA2I) fast(rwfh *os.File, tarFormat tar.Format) (size int64, err erro
 			Mode:     int64(cos.PermRWRR),
 			Format:   tarFormat,
 		}
+		off, _ = rwfh.Seek(0, io.SeekCurrent) // end of the original archive
 	)
+	archive.SetTarCksum(&hdr, a.cksum)
 	tw.WriteHeader(&hdr)
 	_, err = io.CopyBuffer(tw, a.r, buf) // append
 	cos.Close(tw)
+	if err == nil {
+		if err = a.validate(); err != nil {
+			// undo: truncate and restore the trailer
+			if errV := rwfh.Truncate(off); errV == nil {
+				rwfh.Seek(off, io.SeekStart)
+				tar.NewWriter(rwfh).Close()
+			}
+		}
+	}
 	if err == nil {
 		size, err = rwfh.Seek(0, io.SeekCurrent)
 	}
@@ -1723,6 +1939,31 @@ func (a *putA2I) fast(rwfh *os.File, tarFormat tar.Format) (size int64, err erro
 	return
 }
 
+// archive writer options: store the checksum (if provided) with the archived file
+func (a *putA2I) opts() *archive.Opts {
+	if a.cksum == nil {
+		return nil
+	}
+	cb := func(hdr any) {
+		if thdr, ok := hdr.(*tar.Header); ok {
+			archive.SetTarCksum(thdr, a.cksum)
+		}
+	}
+	return &archive.Opts{CB: cb}
+}
+
+// validate (the checksum of) appended bytes
+func (a *putA2I) validate() error {
+	if a.cksumH == nil {
+		return nil
+	}
+	a.cksumH.Finalize()
+	if a.cksumH.Equal(a.cksum) {
+		return nil
+	}
+	return cos.NewErrDataCksum(&a.cksumH.Cksum, a.cksum, a.lom.Cname()+"/"+a.filename)
+}
+
 func (*putA2I) reterr(err error) (int, error) {
 	errCode := http.StatusInternalServerError
 	if cmn.IsErrCapExceeded(err) {
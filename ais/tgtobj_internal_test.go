@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"errors"
 	"flag"
 	"io"
 	"net/http"
@@ -91,6 +92,147 @@ func TestMain(m *testing.M) {
 	m.Run()
 }
 
+func TestECRetriable(t *testing.T) {
+	var (
+		errMissing = errors.New("cannot restore: too many slices missing (found 1 slices, need 2 or more)")
+		errCap     = cmn.NewErrCapExceeded(99, 100, 90, 95, 99, true /*oos*/)
+	)
+	tests := []struct {
+		err        error
+		enough     bool
+		rebRunning bool
+		retry      bool
+	}{
+		{errMissing, true, false, false}, // all targets in, no rebalance: permanent
+		{errMissing, false, false, true}, // targets are down or restarting
+		{errMissing, true, true, true},   // rebalancing
+		{errCap, false, true, false},     // out of space
+	}
+	for _, test := range tests {
+		if retry := ecRetriable(test.err, test.enough, test.rebRunning); retry != test.retry {
+			t.Errorf("ecRetriable(%v, enough=%t, reb=%t): expected %t, got %t",
+				test.err, test.enough, test.rebRunning, test.retry, retry)
+		}
+	}
+}
+
+// MD5 is computed while writing and stored with the object (apc.QparamStoreMD5)
+func TestPutStoreMD5(t *testing.T) {
+	lom := core.AllocLOM("md5obj")
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(lom.FQN)
+
+	data := []byte("the quick brown fox jumps over the lazy dog")
+	poi := newTestPOI(lom, readers.NewBytes(data))
+	poi.storeMD5 = true
+	if _, err := poi.putObject(); err != nil {
+		t.Fatal(err)
+	}
+
+	md5 := cos.NewCksumHash(cos.ChecksumMD5)
+	md5.H.Write(data)
+	md5.Finalize()
+	lom.UncacheUnless()
+	if err := lom.Load(false, false); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := lom.GetCustomKey(cmn.MD5ObjMD); v != md5.Value() {
+		t.Errorf("expected stored md5 %q, got %q", md5.Value(), v)
+	}
+}
+
+// max object size (bucket property): known size, streamed (unknown) size, and APPEND
+func TestPutMaxObjSize(t *testing.T) {
+	const limit = 16
+	lom := core.AllocLOM("maxobj")
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(lom.FQN)
+	lom.Bprops().MaxObjSize = limit
+	defer func() { lom.Bprops().MaxObjSize = 0 }()
+
+	if _, err := checkObjSize(lom, limit); err != nil {
+		t.Errorf("expected size %d to be within the limit, got %v", limit, err)
+	}
+	if errCode, err := checkObjSize(lom, limit+1); !cmn.IsErrObjTooLarge(err) || errCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected %d (too large), got %d: %v", http.StatusRequestEntityTooLarge, errCode, err)
+	}
+
+	// streamed
+	poi := newTestPOI(lom, readers.NewBytes(make([]byte, 2*limit)))
+	poi.maxSize = limit
+	if errCode, err := poi.putObject(); !cmn.IsErrObjTooLarge(err) || errCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected %d (too large), got %d: %v", http.StatusRequestEntityTooLarge, errCode, err)
+	}
+	if _, err := os.Stat(lom.FQN); !os.IsNotExist(err) {
+		t.Errorf("expected %s not to exist, got %v", lom, err)
+	}
+	poi = newTestPOI(lom, readers.NewBytes(make([]byte, limit/2)))
+	poi.maxSize = limit
+	if _, err := poi.putObject(); err != nil {
+		t.Fatal(err)
+	}
+
+	// append
+	alom := core.AllocLOM("maxobj-apnd")
+	defer core.FreeLOM(alom)
+	if err := alom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, cos.KiB)
+	aoi := newTestAOI(alom, readers.NewBytes(make([]byte, limit/2)))
+	hdl, _, err := aoi.apnd(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aoi = newTestAOI(alom, readers.NewBytes(make([]byte, limit)))
+	if err := aoi.parse(hdl); err != nil {
+		t.Fatal(err)
+	}
+	if _, errCode, err := aoi.apnd(buf); !cmn.IsErrObjTooLarge(err) || errCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected %d (too large), got %d: %v", http.StatusRequestEntityTooLarge, errCode, err)
+	}
+	if _, err := os.Stat(aoi.hdl.workFQN); !os.IsNotExist(err) {
+		t.Errorf("expected partially appended %s to be removed, got %v", aoi.hdl.workFQN, err)
+	}
+
+	// (known size)
+	aoi = newTestAOI(alom, readers.NewBytes(make([]byte, limit+1)))
+	aoi.size = limit + 1
+	if _, errCode, err := aoi.apnd(buf); !cmn.IsErrObjTooLarge(err) || errCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected %d (too large), got %d: %v", http.StatusRequestEntityTooLarge, errCode, err)
+	}
+	os.Remove(aoi.hdl.workFQN)
+}
+
+// (the package-level `t` is shadowed inside tests)
+func newTestPOI(lom *core.LOM, r io.ReadCloser) *putOI {
+	return &putOI{
+		atime:   time.Now().UnixNano(),
+		t:       t,
+		lom:     lom,
+		r:       r,
+		workFQN: path.Join(testMountpath, lom.ObjName+".work"),
+		config:  cmn.GCO.Get(),
+		owt:     cmn.OwtPut,
+	}
+}
+
+func newTestAOI(lom *core.LOM, r io.ReadCloser) *apndOI {
+	return &apndOI{
+		started: time.Now().UnixNano(),
+		t:       t,
+		lom:     lom,
+		r:       r,
+		op:      apc.AppendOp,
+	}
+}
+
 func BenchmarkObjPut(b *testing.B) {
 	benches := []struct {
 		fileSize int64
@@ -6,7 +6,7 @@ package ais
 
 import (
 	"context"
-	"errors"
+	"encoding/xml"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -42,6 +42,11 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if r.URL.Query().Has(s3.QparamTagging) {
+		t.objTaggingS3(w, r, apiItems)
+		return
+	}
+
 	switch r.Method {
 	case http.MethodHead:
 		t.headObjS3(w, r, apiItems)
@@ -64,6 +69,71 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// [METHOD] /s3/<bucket-name>/<object-name>?tagging
+// GET, PUT (replace all), and DELETE object's tag set (stored as custom metadata)
+func (t *target) objTaggingS3(w http.ResponseWriter, r *http.Request, items []string) {
+	bck, err, errCode := meta.InitByNameOnly(items[0], t.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	var tagging *s3.Tagging
+	switch r.Method {
+	case http.MethodGet, http.MethodDelete:
+	case http.MethodPut:
+		tagging = &s3.Tagging{}
+		if err := xml.NewDecoder(r.Body).Decode(tagging); err != nil {
+			s3.WriteErr(w, r, err, http.StatusBadRequest)
+			return
+		}
+		if err := tagging.Validate(); err != nil {
+			s3.WriteErr(w, r, err, http.StatusBadRequest)
+			return
+		}
+	default:
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodPut)
+		return
+	}
+
+	lom := core.AllocLOM(s3.ObjName(items))
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	exclusive := r.Method != http.MethodGet
+	lom.Lock(exclusive)
+	defer lom.Unlock(exclusive)
+	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+		if cos.IsNotExist(err, 0) {
+			s3.WriteErr(w, r, err, http.StatusNotFound)
+		} else {
+			s3.WriteErr(w, r, err, 0)
+		}
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		sgl := t.gmm.NewSGL(0)
+		s3.NewTagging(lom.GetCustomMD()).MustMarshal(sgl)
+		w.Header().Set(cos.HdrContentType, cos.ContentXML)
+		sgl.WriteTo(w)
+		sgl.Free()
+		return
+	case http.MethodDelete:
+		tagging = &s3.Tagging{} // i.e., remove all
+	}
+	lom.SetCustomMD(tagging.Apply(lom.GetCustomMD()))
+	if err := lom.Persist(); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if r.Method == http.MethodDelete {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
 // PUT /s3/<bucket-name>/<object-name>
 // [switch] mpt | put | copy
 func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.Config, items []string) {
@@ -81,9 +151,10 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.
 	switch {
 	case q.Has(s3.QparamMptPartNo) && q.Has(s3.QparamMptUploadID):
 		if r.Header.Get(cos.S3HdrObjSrc) != "" {
-			// TODO: copy another object (or its range) => part of the specified multipart upload.
-			// https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html
-			s3.WriteErr(w, r, errors.New("UploadPartCopy not implemented yet"), http.StatusNotImplemented)
+			if cmn.Rom.FastV(5, cos.SmoduleS3) {
+				nlog.Infoln("putMptPartCopy", bck.String(), items, q)
+			}
+			t.putMptPartCopy(w, r, items, q, bck)
 			return
 		}
 		if cmn.Rom.FastV(5, cos.SmoduleS3) {
@@ -144,16 +215,37 @@ func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.C
 		return
 	}
 
-	coiParams := core.AllocCOI()
-	{
-		coiParams.Config = config
-		coiParams.BckTo = bckTo
-		coiParams.ObjnameTo = s3.ObjName(items)
-		coiParams.OWT = cmn.OwtCopy
+	// metadata directive (validated by proxy)
+	var custom cos.StrKVs
+	replace, err := s3.ParseMetaDirective(r.Header)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	if replace {
+		custom = s3.ReplaceMetadata(lom.GetCustomMD(), s3.UserMetadata(r.Header))
+		for k, v := range s3.StdHeaders(r.Header) {
+			custom[k] = v
+		}
+	}
+
+	objNameTo := s3.ObjName(items)
+	if replace && lom.Uname() == bckTo.MakeUname(objNameTo) {
+		// copy onto itself to update metadata
+		err = t.replaceMetaS3(lom, custom)
+	} else {
+		coiParams := core.AllocCOI()
+		{
+			coiParams.Config = config
+			coiParams.BckTo = bckTo
+			coiParams.ObjnameTo = objNameTo
+			coiParams.OWT = cmn.OwtCopy
+			coiParams.CustomMD = custom
+		}
+		coi := (*copyOI)(coiParams)
+		_, err = coi.do(t, nil /*DM*/, lom)
+		core.FreeCOI(coiParams)
 	}
-	coi := (*copyOI)(coiParams)
-	_, err = coi.do(t, nil /*DM*/, lom)
-	core.FreeCOI(coiParams)
 
 	if err != nil {
 		if err == cmn.ErrSkip {
@@ -180,6 +272,16 @@ func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.C
 	sgl.Free()
 }
 
+func (*target) replaceMetaS3(lom *core.LOM, custom cos.StrKVs) error {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	lom.SetCustomMD(custom)
+	return lom.Persist()
+}
+
 func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, config *cmn.Config, lom *core.LOM) {
 	if err := lom.InitBck(bck.Bucket()); err != nil {
 		if cmn.IsErrRemoteBckNotFound(err) {
@@ -191,9 +293,37 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 			return
 		}
 	}
+	// conditional PUT: check early (prior to receiving the payload), and then again under write lock
+	cond, err := s3.ParsePutCond(r.Header)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	contentMD5, err := s3.ParseContentMD5(r.Header)
+	if err != nil {
+		s3.WriteErr(w, r, err, http.StatusBadRequest)
+		return
+	}
+	if cond != nil {
+		if errCode, err := checkPutCond(lom, cond, false /*locked*/); err != nil {
+			s3.WriteErr(w, r, err, errCode)
+			return
+		}
+	}
+
 	started := time.Now()
 	lom.SetAtimeUnix(started.UnixNano())
 
+	// storage class => redundancy (see cmn.S3Conf)
+	class := s3.ParseStorageClass(r.Header)
+	if class != "" {
+		lom.SetCustomKey(cmn.S3StorageClassObjMD, class)
+	}
+	// Cache-Control, Expires, etc. - to return with GET and HEAD
+	for k, v := range s3.StdHeaders(r.Header) {
+		lom.SetCustomKey(k, v)
+	}
+
 	// TODO: dual checksumming, e.g. lom.SetCustom(apc.AWS, ...)
 
 	dpq := dpqAlloc()
@@ -210,6 +340,12 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 		poi.config = config
 		poi.skipVC = cmn.Rom.Features().IsSet(feat.SkipVC) || cos.IsParseBool(dpq.skipVC) // apc.QparamSkipVC
 		poi.restful = true
+		poi.etagMD5 = lom.IsFeatureSet(feat.S3ETagMD5)
+		poi.cond = cond
+		poi.contentMD5 = contentMD5
+		if config.S3.IsReduced(class) {
+			poi.skipEC, poi.skipMirror = true, true
+		}
 	}
 	errCode, err := poi.do(nil /*response hdr*/, r, dpq)
 	freePOI(poi)
@@ -258,6 +394,17 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 		t.listMptParts(w, r, bck, objName, q)
 		return
 	}
+	if q.Has(s3.QparamAttributes) {
+		t.getObjAttrsS3(w, r, bck, objName)
+		return
+	}
+
+	// conditional GET
+	if r.Header.Get(cos.HdrIfNoneMatch) != "" || r.Header.Get(cos.HdrIfModifiedSince) != "" {
+		if t.notModifiedS3(w, r, bck, objName) {
+			return
+		}
+	}
 
 	dpq := dpqAlloc()
 	if err := dpq.parse(r.URL.RawQuery); err != nil {
@@ -276,6 +423,88 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 	dpqFree(dpq)
 }
 
+// GET /s3/<bucket-name>/<object-name>?attributes (GetObjectAttributes)
+// (remote objects that are not present in the cluster are HEAD-ed)
+func (t *target) getObjAttrsS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	attrs := r.Header.Get(cos.S3HdrObjAttrs)
+	if attrs == "" {
+		s3.WriteErr(w, r, fmt.Errorf("missing %q header", cos.S3HdrObjAttrs), 0)
+		return
+	}
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		if !cos.IsNotExist(err, 0) || bck.IsAIS() {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		objAttrs, errCode, err := t.Backend(lom.Bck()).HeadObj(context.Background(), lom)
+		if err != nil {
+			s3.WriteErr(w, r, err, errCode)
+			return
+		}
+		lom.CopyAttrs(objAttrs, false /*skip cksum*/)
+	}
+
+	result := s3.NewObjAttrsResult(lom, attrs)
+	hdr := w.Header()
+	hdr.Set(cos.S3LastModified, cos.FormatNanoTime(lom.AtimeUnix(), cos.RFC1123GMT))
+	if cksum := lom.Checksum(); cksum.Type() != cos.ChecksumNone {
+		hdr.Set(apc.HdrObjCksumType, cksum.Type())
+		hdr.Set(apc.HdrObjCksumVal, cksum.Value())
+	}
+	sgl := t.gmm.NewSGL(0)
+	result.MustMarshal(sgl)
+	hdr.Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// evaluate S3 conditional PUT against the existing object (if any)
+func checkPutCond(lom *core.LOM, cond *s3.PutCond, locked bool) (int, error) {
+	cur := core.AllocLOM(lom.ObjName)
+	defer core.FreeLOM(cur)
+	if err := cur.InitBck(lom.Bucket()); err != nil {
+		return 0, err
+	}
+	err := cur.Load(false /*cache it*/, locked)
+	if err != nil && !cmn.IsErrObjNought(err) {
+		return 0, err
+	}
+	if err := cond.Check(cur, err == nil); err != nil {
+		return http.StatusPreconditionFailed, err
+	}
+	return 0, nil
+}
+
+// Respond with 304 (and return true) iff the (locally present) object matches
+// the conditions - see s3.NotModified.
+// Objects that are not present (remote, not cached) are always GET (cold).
+func (t *target) notModifiedS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) bool {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return false
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		return false
+	}
+	hdr := s3.NotModified(r.Header, lom)
+	if hdr == nil {
+		return false
+	}
+	whdr := w.Header()
+	for k, v := range hdr {
+		whdr[k] = v
+	}
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
 // HEAD /s3/<bucket-name>/<object-name> (TODO: s3.HdrMptCnt)
 // See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_HeadObject.html
 func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []string) {
@@ -300,8 +529,12 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 			return
 		}
 		if bck.IsAIS() {
-			s3.WriteErr(w, r, cos.NewErrNotFound(t, lom.Cname()), 0)
-			return
+			// (e.g., redirected here by proxy when the HRW target is down - see s3AltTarget)
+			if !lom.ECEnabled() || ec.ECM.RestoreObject(lom) != nil || lom.Load(true, false) != nil {
+				s3.WriteErr(w, r, cos.NewErrNotFound(t, lom.Cname()), 0)
+				return
+			}
+			exists = true
 		}
 	}
 
@@ -335,6 +568,10 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 	// (compare w/ `p.listObjectsS3()`
 	lastModified := cos.FormatNanoTime(op.Atime, cos.RFC1123GMT)
 	hdr.Set(cos.S3LastModified, lastModified)
+	if class := s3.StorageClass(custom); class != s3.StorageClassStandard {
+		hdr.Set(s3.HdrStorageClass, class) // (as per S3, not returned for STANDARD)
+	}
+	s3.SetStdHeaders(hdr, custom)
 
 	// TODO: lom.Checksum() via apc.HeaderPrefix+apc.HdrObjCksumType/Val via
 	// s3 obj Metadata map[string]*string
@@ -6,6 +6,7 @@ package ais
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -15,10 +16,12 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/ais/backend"
 	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -76,7 +79,10 @@ func (t *target) startMpt(w http.ResponseWriter, r *http.Request, items []string
 				return
 			}
 
-			s3.InitUpload(result.UploadID, result.Bucket, result.Key)
+			if err := s3.InitUpload(result.UploadID, lom, s3.StdHeaders(r.Header)); err != nil {
+				s3.WriteErr(w, r, err, 0)
+				return
+			}
 			w.Header().Set(cos.HdrContentType, cos.ContentXML)
 			w.Write(resp.Body)
 			return
@@ -90,7 +96,10 @@ func (t *target) startMpt(w http.ResponseWriter, r *http.Request, items []string
 		uploadID = cos.GenUUID()
 	}
 
-	s3.InitUpload(uploadID, bck.Name, objName)
+	if err := s3.InitUpload(uploadID, lom, s3.StdHeaders(r.Header)); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
 	result := &s3.InitiateMptUploadResult{Bucket: bck.Name, Key: objName, UploadID: uploadID}
 
 	sgl := t.gmm.NewSGL(0)
@@ -109,54 +118,167 @@ func (t *target) startMpt(w http.ResponseWriter, r *http.Request, items []string
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPart.html
 func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []string, q url.Values, bck *meta.Bck) {
 	// 1. parse/validate
-	uploadID := q.Get(s3.QparamMptUploadID)
-	if uploadID == "" {
-		s3.WriteErr(w, r, errors.New("empty uploadId"), 0)
+	uploadID, partNum, err := parseMptPart(q)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	part := q.Get(s3.QparamMptPartNo)
-	if part == "" {
-		s3.WriteErr(w, r, fmt.Errorf("upload %q: missing part number", uploadID), 0)
+
+	// 2. init lom
+	objName := s3.ObjName(items)
+	lom := &core.LOM{ObjName: objName}
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	partNum, err := s3.ParsePartNum(part)
+
+	// 3. write and finalize
+	md5, errCode, err := t.writeMptPart(r, q, lom, r.Body, uploadID, partNum, false /*copy*/)
 	if err != nil {
-		s3.WriteErr(w, r, err, 0)
+		s3.WriteMptErr(w, r, err, errCode, lom, uploadID)
 		return
 	}
-	if partNum < 1 || partNum > s3.MaxPartsPerUpload {
-		err := fmt.Errorf("upload %q: invalid part number %d, must be between 1 and %d",
-			uploadID, partNum, s3.MaxPartsPerUpload)
+	w.Header().Set(cos.S3CksumHeader, md5) // s3cmd checks this one
+}
+
+// Copy an existing object (or its range) => part of the specified multipart upload.
+// The source is read from its HRW target (possibly, this one) via regular GET
+// with the `cos.S3HdrObjSrcRange` when specified.
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html
+func (t *target) putMptPartCopy(w http.ResponseWriter, r *http.Request, items []string, q url.Values, bck *meta.Bck) {
+	uploadID, partNum, err := parseMptPart(q)
+	if err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-
-	// 2. init lom, create part file
 	objName := s3.ObjName(items)
 	lom := &core.LOM{ObjName: objName}
 	if err := lom.InitBck(bck.Bucket()); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+
+	// source
+	resp, cancel, errCode, err := t.getMptCopySrc(r)
+	if err != nil {
+		s3.WriteMptErr(w, r, err, errCode, lom, uploadID)
+		return
+	}
+	md5, errCode, err := t.writeMptPart(r, q, lom, resp.Body, uploadID, partNum, true /*copy*/)
+	cos.Close(resp.Body)
+	cancel()
+	if err != nil {
+		s3.WriteMptErr(w, r, err, errCode, lom, uploadID)
+		return
+	}
+
+	result := s3.CopyPartResult{
+		LastModified: cos.FormatNanoTime(time.Now().UnixNano(), cos.ISO8601),
+		ETag:         md5,
+	}
+	sgl := t.gmm.NewSGL(0)
+	result.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+func parseMptPart(q url.Values) (uploadID string, partNum int32, err error) {
+	uploadID = q.Get(s3.QparamMptUploadID)
+	if uploadID == "" {
+		return "", 0, errors.New("empty uploadId")
+	}
+	part := q.Get(s3.QparamMptPartNo)
+	if part == "" {
+		return "", 0, fmt.Errorf("upload %q: missing part number", uploadID)
+	}
+	if partNum, err = s3.ParsePartNum(part); err != nil {
+		return "", 0, err
+	}
+	if partNum < 1 || partNum > s3.MaxPartsPerUpload {
+		err = fmt.Errorf("upload %q: invalid part number %d, must be between 1 and %d",
+			uploadID, partNum, s3.MaxPartsPerUpload)
+	}
+	return uploadID, partNum, err
+}
+
+// GET UploadPartCopy source (or its range) from the target that has it
+// (the caller closes response body and cancels)
+func (t *target) getMptCopySrc(r *http.Request) (*http.Response, context.CancelFunc, int, error) {
+	src := strings.Trim(r.Header.Get(cos.S3HdrObjSrc), "/") // in AWS examples the path starts with "/"
+	parts := strings.SplitN(src, "/", 2)
+	if len(parts) < 2 {
+		return nil, nil, 0, errS3Obj
+	}
+	bckSrc, err, errCode := meta.InitByNameOnly(parts[0], t.owner.bmd)
+	if err != nil {
+		return nil, nil, errCode, err
+	}
+	if err := bckSrc.Allow(apc.AceGET); err != nil {
+		return nil, nil, http.StatusForbidden, err
+	}
+	objSrc := strings.Trim(parts[1], "/")
+	tsi, err := t.owner.smap.get().HrwName2T(bckSrc.MakeUname(objSrc))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodGet
+		reqArgs.Base = tsi.URL(cmn.NetIntraData)
+		reqArgs.Header = http.Header{
+			apc.HdrCallerID:   []string{t.SID()},
+			apc.HdrCallerName: []string{t.callerName()},
+		}
+		if rng := r.Header.Get(cos.S3HdrObjSrcRange); rng != "" {
+			reqArgs.Header.Set(cos.HdrRange, rng)
+		}
+		reqArgs.Path = apc.URLPathObjects.Join(bckSrc.Name, objSrc)
+		reqArgs.Query = bckSrc.NewQuery()
+	}
+	req, _, cancel, err := reqArgs.ReqWithTimeout(cmn.GCO.Get().Timeout.SendFile.D())
+	cmn.FreeHra(reqArgs)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	resp, err := g.client.data.Do(req) //nolint:bodyclose // closed by the caller
+	if err != nil {
+		cancel()
+		return nil, nil, 0, err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+		cos.DrainReader(resp.Body)
+		resp.Body.Close()
+		cancel()
+		err = fmt.Errorf("%s: failed to read %s from %s: %s", t, bckSrc.Cname(objSrc), tsi, resp.Status)
+		return nil, nil, resp.StatusCode, err
+	}
+	return resp, cancel, 0, nil
+}
+
+// create part file, write, and add the part to the upload (see s3.AddPart re persistence);
+// when copying (UploadPartCopy), there's no request payload to validate and no presigned request to forward
+func (t *target) writeMptPart(r *http.Request, q url.Values, lom *core.LOM, body io.Reader, uploadID string,
+	partNum int32, isCopy bool) (md5 string, errCode int, err error) {
 	// workfile name format: <upload-id>.<part-number>.<obj-name>
 	prefix := uploadID + "." + strconv.FormatInt(int64(partNum), 10)
 	wfqn := fs.CSM.Gen(lom, fs.WorkfileType, prefix)
 	partFh, errC := lom.CreateFileRW(wfqn)
 	if errC != nil {
-		s3.WriteMptErr(w, r, errC, 0, lom, uploadID)
-		return
+		return "", 0, errC
 	}
 
-	// 3. write
+	// write
 	var (
 		etag         string
-		errCode      int
+		size         int64
 		partSHA      = r.Header.Get(cos.S3HdrContentSHA256)
-		checkPartSHA = partSHA != "" && partSHA != cos.S3UnsignedPayload
+		checkPartSHA = !isCopy && partSHA != "" && partSHA != cos.S3UnsignedPayload
 		buf, slab    = t.gmm.Alloc()
 		cksumSHA     = &cos.CksumHash{}
 		cksumMD5     = &cos.CksumHash{}
-		remote       = bck.IsRemoteS3()
+		remote       = lom.Bck().IsRemoteS3()
 	)
 	if checkPartSHA {
 		cksumSHA = cos.NewCksumHash(cos.ChecksumSHA256)
@@ -165,17 +287,17 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		cksumMD5 = cos.NewCksumHash(cos.ChecksumMD5)
 	}
 	mw := multiWriter(cksumMD5.H, cksumSHA.H, partFh)
-	size, err := io.CopyBuffer(mw, r.Body, buf)
+	size, err = io.CopyBuffer(mw, body, buf)
 	slab.Free(buf)
 
-	// 4. rewind and call s3 API
+	// rewind and call s3 API
 	if err == nil && remote {
 		if _, err = partFh.Seek(0, io.SeekStart); err == nil {
-			var (
-				resp *s3.PresignedResp
-				pts  = s3.NewPresignedReq(r, lom, partFh, q)
-			)
-			resp, err = pts.Do(g.client.data)
+			var resp *s3.PresignedResp
+			if !isCopy {
+				pts := s3.NewPresignedReq(r, lom, partFh, q)
+				resp, err = pts.Do(g.client.data)
+			}
 			if resp != nil {
 				errCode = resp.StatusCode
 				etag = cmn.UnquoteCEV(resp.Header.Get(cos.HdrETag))
@@ -190,13 +312,12 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		if nerr := cos.RemoveFile(wfqn); nerr != nil && !os.IsNotExist(nerr) {
 			nlog.Errorf(fmtNested, t, err, "remove", wfqn, nerr)
 		}
-		s3.WriteMptErr(w, r, err, errCode, lom, uploadID)
-		return
+		return "", errCode, err
 	}
 
-	// 5. finalize part
+	// finalize part
 	// expecting the part's remote etag to be md5 checksum, not computing otherwise
-	md5 := etag
+	md5 = etag
 	if cksumMD5.H != nil {
 		debug.Assert(etag == "")
 		cksumMD5.Finalize()
@@ -208,8 +329,7 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		if !cksumSHA.Equal(recvSHA) {
 			detail := fmt.Sprintf("upload %q, %s, part %d", uploadID, lom, partNum)
 			err = cos.NewErrDataCksum(&cksumSHA.Cksum, recvSHA, detail)
-			s3.WriteMptErr(w, r, err, http.StatusInternalServerError, lom, uploadID)
-			return
+			return "", http.StatusInternalServerError, err
 		}
 	}
 	npart := &s3.MptPart{
@@ -218,11 +338,13 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		Size: size,
 		Num:  partNum,
 	}
-	if err := s3.AddPart(uploadID, npart); err != nil {
-		s3.WriteMptErr(w, r, err, 0, lom, uploadID)
-		return
+	if err = s3.AddPart(uploadID, lom, npart); err != nil {
+		if nerr := cos.RemoveFile(wfqn); nerr != nil && !os.IsNotExist(nerr) {
+			nlog.Errorf(fmtNested, t, err, "remove", wfqn, nerr)
+		}
+		return "", 0, err
 	}
-	w.Header().Set(cos.S3CksumHeader, md5) // s3cmd checks this one
+	return md5, 0, nil
 }
 
 // Complete multipart upload.
@@ -259,7 +381,12 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	size, errN := s3.ObjSize(uploadID)
+	size, errN := s3.ObjSize(uploadID, lom)
+	if errN != nil {
+		s3.WriteMptErr(w, r, errN, 0, lom, uploadID)
+		return
+	}
+	md, errN := s3.UploadMD(uploadID, lom)
 	if errN != nil {
 		s3.WriteMptErr(w, r, errN, 0, lom, uploadID)
 		return
@@ -306,7 +433,7 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 	sort.Slice(partList.Parts, func(i, j int) bool {
 		return partList.Parts[i].PartNumber < partList.Parts[j].PartNumber
 	})
-	nparts, err := s3.CheckParts(uploadID, partList.Parts)
+	nparts, err := s3.CheckParts(uploadID, lom, partList.Parts)
 	if err != nil {
 		s3.WriteMptErr(w, r, err, 0, lom, uploadID)
 		return
@@ -366,6 +493,9 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 	// .5 finalize
 	lom.SetSize(size)
 	lom.SetCustomKey(cmn.ETag, etag)
+	for k, v := range md { // Cache-Control, Expires, etc. - as specified by CreateMultipartUpload
+		lom.SetCustomKey(k, v)
+	}
 
 	poi := allocPOI()
 	{
@@ -379,7 +509,7 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 	freePOI(poi)
 
 	// .6 cleanup parts - unconditionally
-	exists := s3.CleanupUpload(uploadID, lom.FQN, false /*aborted*/)
+	exists := s3.CleanupUpload(uploadID, lom, false /*aborted*/)
 	debug.Assert(exists)
 
 	if errF != nil {
@@ -458,7 +588,7 @@ func (t *target) abortMpt(w http.ResponseWriter, r *http.Request, items []string
 		}
 	}
 
-	exists := s3.CleanupUpload(uploadID, "", true /*aborted*/)
+	exists := s3.CleanupUpload(uploadID, lom, true /*aborted*/)
 	if !exists {
 		err := fmt.Errorf("upload %q does not exist", uploadID)
 		s3.WriteErr(w, r, err, http.StatusNotFound)
@@ -489,6 +619,7 @@ func (t *target) listMptParts(w http.ResponseWriter, r *http.Request, bck *meta.
 		return
 	}
 	result := &s3.ListPartsResult{Bucket: bck.Name, Key: objName, UploadID: uploadID, Parts: parts}
+	result.Paginate(q)
 	sgl := t.gmm.NewSGL(0)
 	result.MustMarshal(sgl)
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
@@ -511,7 +642,7 @@ func (t *target) listMptUploads(w http.ResponseWriter, bck *meta.Bck, q url.Valu
 		}
 	}
 	idMarker = q.Get(s3.QparamMptUploadIDMarker)
-	result := s3.ListUploads(bck.Name, idMarker, maxUploads)
+	result := s3.ListUploads(bck.Bucket(), idMarker, maxUploads)
 	sgl := t.gmm.NewSGL(0)
 	result.MustMarshal(sgl)
 	w.Header().Set(cos.HdrContentType, cos.ContentXML)
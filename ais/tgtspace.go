@@ -28,10 +28,14 @@ const (
 	// - note that an API call (e.g. CLI) will go through anyway
 	// - compare with cmn/cos/oom.go
 	minAutoDetectInterval = 10 * time.Minute
+
+	// housekeeping: remove expired trash (soft-deleted objects)
+	trashHkIval = 30 * time.Minute
 )
 
 var (
-	lastTrigOOS atomic.Int64
+	lastTrigOOS  atomic.Int64
+	freeingTrash atomic.Bool
 )
 
 // triggers by an out-of-space condition or a suspicion of thereof
@@ -51,6 +55,18 @@ func (t *target) OOS(csRefreshed *fs.CapStatus) (cs fs.CapStatus) {
 	if errCap == nil {
 		return // unlikely; nothing to do
 	}
+	// soft-deleted objects go first, expired or not (compare w/ space.PurgeTrash)
+	if cs.IsOOS() && freeingTrash.CAS(false, true) {
+		size := space.FreeTrash(cmn.GCO.Get())
+		freeingTrash.Store(false)
+		if size > 0 {
+			nlog.Warningln(t.String(), "out of space: removed", cos.ToSizeIEC(size, 2), "of trash")
+			var err error
+			if cs, err, errCap = fs.CapRefresh(nil, nil); err != nil || errCap == nil {
+				return
+			}
+		}
+	}
 	if prev := lastTrigOOS.Load(); mono.Since(prev) < minAutoDetectInterval {
 		nlog.Warningf("%s: _not_ running store cleanup: (%v, %v), %s", t, prev, minAutoDetectInterval, cs.String())
 		return
@@ -69,6 +85,16 @@ func (t *target) OOS(csRefreshed *fs.CapStatus) (cs fs.CapStatus) {
 	return
 }
 
+// remove expired soft-deleted objects (see space.PurgeTrash)
+func (t *target) hkTrash() time.Duration {
+	if ttl := cmn.GCO.Get().Space.TrashTTL.D(); ttl > 0 {
+		if n, size := space.PurgeTrash(ttl); n > 0 {
+			nlog.Infoln(t.String(), "removed", n, "expired trashed object"+cos.Plural(int(n)), "("+cos.ToSizeIEC(size, 2)+")")
+		}
+	}
+	return trashHkIval
+}
+
 func (t *target) runLRU(id string, wg *sync.WaitGroup, force bool, bcks ...cmn.Bck) {
 	regToIC := id == ""
 	if regToIC {
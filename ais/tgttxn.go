@@ -118,6 +118,12 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 				t.writeErr(w, r, err)
 				return
 			}
+		} else if tcbmsg.Transcode != "" {
+			var err error
+			if dp, err = xs.NewTranscodeDP(tcbmsg.Transcode); err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
 		}
 		xid, err = t.tcb(c, tcbmsg, dp)
 	case apc.ActCopyObjects, apc.ActETLObjects:
@@ -140,6 +146,12 @@ func (t *target) txnHandler(w http.ResponseWriter, r *http.Request) {
 				t.writeErr(w, r, err)
 				return
 			}
+		} else if tcomsg.Transcode != "" {
+			var err error
+			if dp, err = xs.NewTranscodeDP(tcomsg.Transcode); err != nil {
+				t.writeErr(w, r, err)
+				return
+			}
 		}
 		xid, err = t.tcobjs(c, tcomsg, dp)
 	case apc.ActECEncode:
@@ -705,7 +717,12 @@ func (t *target) tcobjs(c *txnSrv, msg *cmn.TCObjsMsg, dp core.DP) (xid string,
 			done = true
 		}
 
-		txnTco.xtco.Do(txnTco.msg)
+		if err := txnTco.xtco.Do(txnTco.msg); err != nil {
+			if !done {
+				t.transactions.find(c.uuid, ActCleanup)
+			}
+			return "", err
+		}
 		xid = txnTco.xtco.ID()
 		if !done {
 			t.transactions.find(c.uuid, apc.ActCommit)
@@ -1066,6 +1083,7 @@ func (t *target) prmNumFiles(c *txnSrv, txnPrm *txnPromote, confirmedFshare bool
 				ObjName:      objName,
 				OverwriteDst: txnPrm.msg.OverwriteDst,
 				DeleteSrc:    txnPrm.msg.DeleteSrc,
+				ComputeCksum: txnPrm.msg.ComputeCksum,
 			},
 		}
 		if _, err := t.Promote(&params); err != nil {
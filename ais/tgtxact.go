@@ -144,11 +144,36 @@ func (t *target) httpxput(w http.ResponseWriter, r *http.Request) {
 		}
 		flt := xreg.Flt{ID: xargs.ID, Kind: xargs.Kind, Bck: bck}
 		xreg.DoAbort(flt, err)
+	case apc.ActXactUpdate:
+		t.xupdate(w, r, &xargs, msg)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
 }
 
+func (t *target) xupdate(w http.ResponseWriter, r *http.Request, xargs *xact.ArgsMsg, msg *apc.ActMsg) {
+	bw, err := strconv.ParseInt(msg.Name, 10, 64)
+	if err != nil {
+		t.writeErrf(w, r, "%s: invalid max bandwidth %q: %v", msg.Action, msg.Name, err)
+		return
+	}
+	xctn, err := xreg.GetXact(xargs.ID)
+	if err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	if xctn == nil || xctn.Finished() {
+		// not running here (e.g., nothing to copy on this target) - nothing to do
+		return
+	}
+	xtcb, ok := xctn.(*xs.XactTCB)
+	if !ok {
+		t.writeErrf(w, r, "%s: cannot update %s - not supported", msg.Action, xctn)
+		return
+	}
+	xtcb.SetMaxBW(bw)
+}
+
 func (t *target) xget(w http.ResponseWriter, r *http.Request, what, uuid string) {
 	if what != apc.WhatXactStats {
 		t.writeErrf(w, r, fmtUnknownQue, what)
@@ -285,5 +310,7 @@ func (t *target) httpxpost(w http.ResponseWriter, r *http.Request) {
 		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, "special", amsg.Value, err)
 		return
 	}
-	xtco.Do(&tcomsg)
+	if err = xtco.Do(&tcomsg); err != nil {
+		t.writeErr(w, r, err)
+	}
 }
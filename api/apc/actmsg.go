@@ -29,6 +29,8 @@ const (
 	ActCopyBck = "copy-bck"
 	ActETLBck  = "etl-bck"
 
+	ActCopyObject = "copy-obj" // single-object server-side copy (see api.CopyObject)
+
 	ActETLInline = "etl-inline"
 
 	ActDsort    = "dsort"
@@ -56,6 +58,7 @@ const (
 	ActNewPrimary     = "new-primary"
 	ActPromote        = "promote"
 	ActRenameObject   = "rename-obj"
+	ActRestoreObject  = "restore-obj" // soft-deleted (see config.Space.TrashTTL)
 
 	// cp (reverse)
 	ActResetStats  = "reset-stats"
@@ -115,8 +118,9 @@ const (
 	ActMountpathDisable = "disable-mp"
 
 	// Actions on xactions
-	ActXactStop  = Stop
-	ActXactStart = Start
+	ActXactStop   = Stop
+	ActXactStart  = Start
+	ActXactUpdate = "update-xact" // change runtime parameter(s) of a running xaction, e.g. max bandwidth
 
 	// auxiliary
 	ActTransient = "transient" // transient - in-memory only
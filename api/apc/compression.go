@@ -24,3 +24,18 @@ const LZ4Compression = "lz4"
 var SupportedCompression = []string{CompressNever, CompressAlways}
 
 func IsValidCompression(c string) bool { return c == "" || cos.StringInSlice(c, SupportedCompression) }
+
+// Compression level enum (sender-side only: lz4 frames are self-describing,
+// and the receiver decodes whatever the sender selected)
+// - fast: default lz4 block compression
+// - high: lz4 HC - higher compression ratio at the cost of (sender's) CPU; e.g., for WAN copies
+const (
+	CompressLevelFast = "fast"
+	CompressLevelHigh = "high"
+)
+
+var SupportedCompressionLevel = []string{CompressLevelFast, CompressLevelHigh}
+
+func IsValidCompressionLevel(l string) bool {
+	return l == "" || cos.StringInSlice(l, SupportedCompressionLevel)
+}
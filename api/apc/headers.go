@@ -87,6 +87,9 @@ const (
 
 	HdrXactionID = HeaderPrefix + "xaction-id"
 
+	// copying large objects in chunks: total size (see QparamChunk)
+	HdrChunkedSize = HeaderPrefix + "chunked-size"
+
 	// Stream related headers.
 	HdrSessID   = HeaderPrefix + "session-id"
 	HdrCompress = HeaderPrefix + "compress" // LZ4Compression, etc.
@@ -40,6 +40,9 @@ type (
 		TxnUUID string `json:"-"`
 		TCBMsg
 		ContinueOnError bool `json:"coer"`
+		// number of concurrent copying workers per target (max MaxTCONumWorkers);
+		// zero or one (default): copy source objects one at a time
+		NumWorkers int `json:"num-workers,omitempty"`
 	}
 )
 
@@ -17,4 +17,7 @@ type PromoteArgs struct {
 	// and _not_ to try to auto-detect if it is;
 	// (auto-detection takes time, etc.)
 	SrcIsNotFshare bool `json:"notshr,omitempty"` // the source is not a file share equally accessible by all targets
+	// compute (and store) the bucket-configured checksum and MD5-based S3 ETag
+	// at promote time - don't trust any precomputed values
+	ComputeCksum bool `json:"cksum,omitempty"`
 }
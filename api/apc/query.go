@@ -81,6 +81,10 @@ const (
 	// - we simply don't care.
 	QparamSkipVC = "skip_vc"
 
+	// PUT: compute MD5 of the object's content while writing it and store the result
+	// as the object's custom metadata (`cmn.MD5ObjMD`); not supported when appending
+	QparamStoreMD5 = "store_md5"
+
 	// force operation
 	// used to overcome certain restrictions, e.g.:
 	// - shutdown the primary and the entire cluster
@@ -148,6 +152,7 @@ const (
 	QparamRebData          = "rbd" // true: get EC rebalance data (pulling data if push way fails)
 	QparamClusterInfo      = "cii" // true: /Health to return cluster info and status
 	QparamOWT              = "owt" // object write transaction enum { OwtPut, ..., OwtGet* }
+	QparamChunk            = "chk" // copying large objects in chunks: byte offset, or one of the ChunkDone, ChunkAbort
 
 	QparamDontResilver = "dntres" // true: do not resilver data off of mountpaths that are being disabled/detached
 
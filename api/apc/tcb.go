@@ -6,11 +6,44 @@ package apc
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/NVIDIA/aistore/cmn/cos"
 )
 
+// max number of concurrent (per mountpath) transformations - see 'tcb.parallelism'
+const MaxTCBParallelism = 64
+
+// copying large objects in chunks: max number of concurrent chunks per object - see 'tcb.chunk_workers'
+// (zero selects the default)
+const (
+	DfltTCBChunkWorkers = 4
+	MaxTCBChunkWorkers  = 32
+)
+
+// multi-object copy/transform (x-tco): number of failed object names to keep and report
+// (see 'tcb.max_failed_names'; zero selects the default)
+const (
+	DfltTCOFailedNames = 32
+	MaxTCOFailedNames  = 1024
+)
+
+// multi-object copy/transform (x-tco): max number of concurrent workers per target (see TCObjsMsg.NumWorkers)
+const MaxTCONumWorkers = 64
+
+// built-in transcoding (format conversion) of the source objects while copying (see CopyBckMsg.Transcode)
+const (
+	// gzip-compress source objects with ".tar" extension and store them with ".tgz" extension
+	// (all other objects are copied as is)
+	TranscodeTar2Tgz = "tar2tgz"
+)
+
+var SupportedTranscode = []string{TranscodeTar2Tgz}
+
+func IsValidTranscode(v string) bool { return v == "" || cos.StringInSlice(v, SupportedTranscode) }
+
 // copy & (offline) transform bucket to bucket
 type (
 	CopyBckMsg struct {
@@ -20,10 +53,36 @@ type (
 		Force     bool   `json:"force"`       // force running in presence of "limited coexistence" type conflicts
 		LatestVer bool   `json:"latest-ver"`  // see also: QparamLatestVer, 'versioning.validate_warm_get', PrefetchMsg
 		Sync      bool   `json:"synchronize"` // see also: 'versioning.synchronize'
+		PreCount  bool   `json:"pre-count"`   // count (locally present) source objects prior to copying - to report progress
+		Regex     string `json:"regex"`       // (optional) copy only those source objects that match (applied after `Prefix`)
+		// (optional) copy only those source objects that have the specified custom property,
+		// formatted as "key=value" (applied after `Prefix`)
+		CustomFilter string `json:"custom-filter,omitempty"`
+		// (optional) bucket-to-bucket: max aggregate copying bandwidth (bytes per second) per target;
+		// can be changed at runtime - see api.SetXactMaxBW
+		MaxBW int64 `json:"max-bw,omitempty"`
+		// (optional) destination naming: replace source name prefix ReplPrefix with ReplPrefixTo
+		// (applied prior to Prepend; non-matching names remain unchanged)
+		ReplPrefix   string `json:"repl-prefix,omitempty"`
+		ReplPrefixTo string `json:"repl-prefix-to,omitempty"`
+		// (optional) bucket-to-bucket: ID of a previously aborted copy job to resume from its (persistent) checkpoint,
+		// skipping source objects that the latter has already visited
+		Resume string `json:"resume,omitempty"`
+		// (optional) bucket-to-bucket: persist progress checkpoints, to be able to resume the job if aborted
+		// (implied by Resume - that is, a resumed job can be resumed again)
+		Checkpoint bool `json:"checkpoint,omitempty"`
+		// (optional) bucket-to-bucket: compress intra-cluster traffic at the specified level
+		// (enum { CompressLevelFast, CompressLevelHigh }); overrides 'tcb.compression' and 'tcb.compression_level'
+		CompressionLevel string `json:"compression-level,omitempty"`
+		// (optional) built-in transcoding of the source objects, one of SupportedTranscode;
+		// destination names are adjusted accordingly (e.g., "abc.tar" => "abc.tgz")
+		Transcode string `json:"transcode,omitempty"`
 	}
 	Transform struct {
 		Name    string       `json:"id,omitempty"`
 		Timeout cos.Duration `json:"request_timeout,omitempty"`
+		// number of concurrent transformations per mountpath (overrides 'tcb.parallelism'; zero: use config)
+		Parallelism int `json:"parallelism,omitempty"`
 	}
 	TCBMsg struct {
 		// NOTE: objname extension ----------------------------------------------------------------------
@@ -44,13 +103,77 @@ type (
 
 func (msg *TCBMsg) Validate(isEtl bool) (err error) {
 	if isEtl && msg.Transform.Name == "" {
-		err = errors.New("ETL name can't be empty")
+		return errors.New("ETL name can't be empty")
+	}
+	if msg.Transform.Parallelism < 0 || msg.Transform.Parallelism > MaxTCBParallelism {
+		return fmt.Errorf("invalid parallelism %d (expected range [0, %d])", msg.Transform.Parallelism, MaxTCBParallelism)
+	}
+	if msg.ReplPrefix == "" && msg.ReplPrefixTo != "" {
+		return fmt.Errorf("invalid prefix replacement: empty source prefix (to %q)", msg.ReplPrefixTo)
+	}
+	if msg.Resume != "" {
+		switch {
+		case !cos.IsValidUUID(msg.Resume):
+			return fmt.Errorf("invalid job ID %q to resume", msg.Resume)
+		case isEtl:
+			return fmt.Errorf("resuming (%s) is not supported for bucket transformations", msg.Resume)
+		case msg.Sync:
+			return fmt.Errorf("resuming (%s) is incompatible with synchronizing source and destination", msg.Resume)
+		}
+	}
+	if msg.Checkpoint {
+		switch {
+		case isEtl:
+			return errors.New("checkpointing is not supported for bucket transformations")
+		case msg.Sync:
+			return errors.New("checkpointing is incompatible with synchronizing source and destination")
+		}
+	}
+	if msg.Transcode != "" {
+		switch {
+		case !IsValidTranscode(msg.Transcode):
+			return fmt.Errorf("invalid transcode %q (expecting one of: %v)", msg.Transcode, SupportedTranscode)
+		case isEtl:
+			return fmt.Errorf("transcoding (%s) cannot be combined with ETL", msg.Transcode)
+		case msg.Sync:
+			return fmt.Errorf("transcoding (%s) is incompatible with synchronizing source and destination", msg.Transcode)
+		}
+	}
+	if !IsValidCompressionLevel(msg.CompressionLevel) {
+		return fmt.Errorf("invalid compression level %q (expecting one of: %v)", msg.CompressionLevel, SupportedCompressionLevel)
+	}
+	if msg.MaxBW < 0 {
+		return fmt.Errorf("invalid max bandwidth %d (expecting non-negative bytes per second)", msg.MaxBW)
+	}
+	if msg.CustomFilter != "" {
+		if _, _, err = msg.ParseCustomFilter(); err != nil {
+			return err
+		}
+	}
+	if msg.Regex != "" {
+		if _, err = regexp.Compile(msg.Regex); err != nil {
+			err = fmt.Errorf("invalid regex %q: %v", msg.Regex, err)
+		}
 	}
 	return
 }
 
-// Replace extension and add suffix if provided.
+// "key=value" => (key, value)
+func (msg *CopyBckMsg) ParseCustomFilter() (key, value string, err error) {
+	var ok bool
+	key, value, ok = strings.Cut(msg.CustomFilter, "=")
+	if !ok || key == "" {
+		err = fmt.Errorf("invalid custom filter %q (expecting \"key=value\")", msg.CustomFilter)
+	}
+	return key, value, err
+}
+
+// Replace extension and prefix, and prepend if provided.
+// NOTE: may return empty string (e.g., when the entire name is replaced with an empty prefix)
 func (msg *TCBMsg) ToName(name string) string {
+	if msg.Transcode == TranscodeTar2Tgz && strings.HasSuffix(name, ".tar") {
+		name = name[:len(name)-len(".tar")] + ".tgz"
+	}
 	if msg.Ext != nil {
 		if idx := strings.LastIndexByte(name, '.'); idx >= 0 {
 			ext := name[idx+1:]
@@ -59,6 +182,9 @@ func (msg *TCBMsg) ToName(name string) string {
 			}
 		}
 	}
+	if msg.ReplPrefix != "" && strings.HasPrefix(name, msg.ReplPrefix) {
+		name = msg.ReplPrefixTo + name[len(msg.ReplPrefix):]
+	}
 	if msg.Prepend != "" {
 		name = msg.Prepend + name
 	}
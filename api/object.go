@@ -88,6 +88,14 @@ type (
 		// - we massively write a new content into a bucket, and/or
 		// - we simply don't care.
 		SkipVC bool
+
+		// Compute MD5 of the content while writing it and store it as
+		// the object's custom property (see apc.QparamStoreMD5)
+		StoreMD5 bool
+
+		// Custom metadata to store with the object, as part of the same PUT
+		// (see also: SetObjectCustomProps)
+		CustomMD cos.StrKVs
 	}
 
 	// (see also: api.PutApndArchArgs)
@@ -271,6 +279,9 @@ func (args *PutArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 	if args.Size != 0 {
 		req.ContentLength = int64(args.Size) // as per https://tools.ietf.org/html/rfc7230#section-3.3.2
 	}
+	for k, v := range args.CustomMD {
+		req.Header.Add(apc.HdrObjCustomMD, k+"="+v)
+	}
 	SetAuxHeaders(req, &args.BaseParams)
 	return req, nil
 }
@@ -422,6 +433,9 @@ func PutObject(args *PutArgs) (oah ObjAttrs, err error) {
 	if args.SkipVC {
 		query.Set(apc.QparamSkipVC, "true")
 	}
+	if args.StoreMD5 {
+		query.Set(apc.QparamStoreMD5, "true")
+	}
 	reqArgs := cmn.AllocHra()
 	{
 		reqArgs.Method = http.MethodPut
@@ -548,6 +562,50 @@ func RenameObject(bp BaseParams, bck cmn.Bck, oldName, newName string) error {
 	return err
 }
 
+// RestoreObject restores a deleted object from the trash
+// (provided soft delete is enabled in the cluster config - see `space.trash_ttl`)
+// - ais:// buckets only
+// - fails if the object has been overwritten since or if its trash TTL has expired
+func RestoreObject(bp BaseParams, bck cmn.Bck, objName string) error {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActRestoreObject})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.NewQuery()
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// CopyObject copies a single object (server-side) - the copying is executed by the target
+// that stores the source, and preserves the source's checksum and custom metadata.
+// - destination bucket must exist (with remote buckets, the usual "on the fly" addition applies)
+// - empty `objNameTo` means the same (source) name
+// - `latestVer` (versioned remote source): copy the latest version - see also apc.QparamLatestVer
+func CopyObject(bp BaseParams, bck cmn.Bck, objName string, bckTo cmn.Bck, objNameTo string, latestVer bool) error {
+	q := bck.NewQuery()
+	_ = bckTo.AddUnameToQuery(q, apc.QparamBckTo)
+	if latestVer {
+		q.Set(apc.QparamLatestVer, "true")
+	}
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathObjects.Join(bck.Name, objName)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActCopyObject, Name: objNameTo})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
 // promote files and directories to ais objects
 func Promote(bp BaseParams, bck cmn.Bck, args *apc.PromoteArgs) (xid string, err error) {
 	actMsg := apc.ActMsg{Action: apc.ActPromote, Name: args.SrcFQN, Value: args}
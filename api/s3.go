@@ -5,12 +5,25 @@
 package api
 
 import (
+	"encoding/xml"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
+	"strconv"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// (compare with ais/s3 query parameters)
+const (
+	s3QparamMptUploads  = "uploads"
+	s3QparamMptUploadID = "uploadId"
+	s3QparamMptPartNo   = "partNumber"
 )
 
 // s3/<bucket-name>/<object-name>
@@ -39,3 +52,110 @@ func GetObjectS3(bp BaseParams, bck cmn.Bck, objectName string, args ...GetArgs)
 	}
 	return wresp.n, nil
 }
+
+//
+// multipart upload via s3/<bucket-name>/<object-name>
+// (see also: https://docs.aws.amazon.com/AmazonS3/latest/API/API_CreateMultipartUpload.html)
+//
+
+type (
+	MptPartS3 struct {
+		ETag       string `xml:"ETag"`
+		PartNumber int32  `xml:"PartNumber"`
+	}
+	mptInitResultS3 struct {
+		UploadID string `xml:"UploadId"`
+	}
+	mptCompleteS3 struct {
+		XMLName xml.Name     `xml:"CompleteMultipartUpload"`
+		Parts   []*MptPartS3 `xml:"Part"`
+	}
+)
+
+// start multipart upload and return upload ID
+func CreateMptUploadS3(bp BaseParams, bck cmn.Bck, objectName string) (string, error) {
+	var (
+		out string
+		q   = bck.AddToQuery(url.Values{s3QparamMptUploads: []string{""}})
+	)
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathS3.Join(bck.Name, objectName)
+		reqParams.Query = q
+	}
+	_, err := reqParams.doReqStr(&out)
+	FreeRp(reqParams)
+	if err != nil {
+		return "", err
+	}
+	var result mptInitResultS3
+	if err := xml.Unmarshal([]byte(out), &result); err != nil {
+		return "", fmt.Errorf("failed to decode multipart upload ID: %v", err)
+	}
+	if result.UploadID == "" {
+		return "", errors.New("failed to start multipart upload: empty upload ID")
+	}
+	return result.UploadID, nil
+}
+
+// upload one part (1 <= partNum <= 10000) and return its ETag; `args.ObjName` is the destination
+func PutMptPartS3(args *PutArgs, uploadID string, partNum int) (string, error) {
+	q := args.Bck.AddToQuery(make(url.Values, 4))
+	q.Set(s3QparamMptUploadID, uploadID)
+	q.Set(s3QparamMptPartNo, strconv.Itoa(partNum))
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodPut
+		reqArgs.Base = args.BaseParams.URL
+		reqArgs.Path = apc.URLPathS3.Join(args.Bck.Name, args.ObjName)
+		reqArgs.Query = q
+		reqArgs.BodyR = args.Reader
+	}
+	resp, err := DoWithRetry(args.BaseParams.Client, args.put, reqArgs) //nolint:bodyclose // is closed inside
+	cmn.FreeHra(reqArgs)
+	if err != nil {
+		return "", err
+	}
+	return resp.Header.Get(cos.S3CksumHeader), nil
+}
+
+// complete multipart upload given all uploaded parts (in any order)
+func CompleteMptUploadS3(bp BaseParams, bck cmn.Bck, objectName, uploadID string, parts []*MptPartS3) error {
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber < parts[j].PartNumber })
+	body, err := xml.Marshal(&mptCompleteS3{Parts: parts})
+	if err != nil {
+		return err
+	}
+	q := bck.AddToQuery(make(url.Values, 3))
+	q.Set(s3QparamMptUploadID, uploadID)
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathS3.Join(bck.Name, objectName)
+		reqParams.Query = q
+		reqParams.Body = body
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentXML}}
+	}
+	err = reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
+
+// abort multipart upload and cleanup all uploaded parts
+func AbortMptUploadS3(bp BaseParams, bck cmn.Bck, objectName, uploadID string) error {
+	q := bck.AddToQuery(make(url.Values, 3))
+	q.Set(s3QparamMptUploadID, uploadID)
+	bp.Method = http.MethodDelete
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathS3.Join(bck.Name, objectName)
+		reqParams.Query = q
+	}
+	err := reqParams.DoRequest()
+	FreeRp(reqParams)
+	return err
+}
@@ -5,9 +5,11 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -19,6 +21,10 @@ import (
 	"github.com/NVIDIA/aistore/xact"
 )
 
+// returned (wrapped) by the WaitForXaction* APIs when the timeout expires,
+// in which case the xaction may still be running
+var ErrWaitTimeout = errors.New("timed out")
+
 // Start xaction
 func StartXaction(bp BaseParams, args *xact.ArgsMsg, extra string) (xid string, err error) {
 	if !xact.Table[args.Kind].Startable {
@@ -60,6 +66,23 @@ func AbortXaction(bp BaseParams, args *xact.ArgsMsg) (err error) {
 	return
 }
 
+// Change max bandwidth (bytes per second, per target) of a running bucket-to-bucket copy or transformation;
+// zero removes the limit (see also: apc.CopyBckMsg.MaxBW)
+func SetXactMaxBW(bp BaseParams, xid string, maxBW int64) (err error) {
+	msg := apc.ActMsg{Action: apc.ActXactUpdate, Value: &xact.ArgsMsg{ID: xid}, Name: strconv.FormatInt(maxBW, 10)}
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	err = reqParams.DoRequest()
+	FreeRp(reqParams)
+	return
+}
+
 //
 // querying and waiting
 //
@@ -245,7 +268,7 @@ func _waitx(bp BaseParams, args *xact.ArgsMsg, fn func(xact.MultiSnap) (bool, bo
 		sleep = min(maxSleep, sleep+sleep/2)
 
 		if elapsed = mono.Since(begin); elapsed >= total {
-			err = fmt.Errorf("api.wait: timed out (%v) waiting for %s", total, args.String())
+			err = fmt.Errorf("api.wait: %w (%v) waiting for %s", ErrWaitTimeout, total, args.String())
 			return
 		}
 	}
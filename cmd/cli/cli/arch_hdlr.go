@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -39,7 +40,9 @@ var (
 		indent1 + "\t- 'local-file s3://q/shard-00123.tar.lz4 --append-or-put --archpath name-in-archive' - append file to a given shard if exists,\n" +
 		indent1 + "\t   otherwise, create a new shard (and name it shard-00123.tar.lz4, as specified);\n" +
 		indent1 + "\t- 'src-dir gs://w/shard-999.zip --append' - archive entire 'src-dir' directory; iff the destination .zip doesn't exist create a new one;\n" +
-		indent1 + "\t- '\"sys, docs\" ais://dst/CCC.tar --dry-run -y -r --archpath ggg/' - dry-run to recursively archive two directories.\n" +
+		indent1 + "\t- '\"sys, docs\" ais://dst/CCC.tar --dry-run -y -r --archpath ggg/' - dry-run to recursively archive two directories;\n" +
+		indent1 + "\t- '- ais://nnn/shard.tar --append --archpath out.log --cksum-type crc32c' - append standard input as 'out.log', computing its crc32c\n" +
+		indent1 + "\t   (or, '--crc32c VALUE' to validate a known checksum).\n" +
 		indent1 + "\tTips:\n" +
 		indent1 + "\t- use '--dry-run' if in doubt;\n" +
 		indent1 + "\t- to archive objects from a ais:// or remote bucket, run 'ais archive bucket' (see --help for details)."
@@ -71,7 +74,13 @@ var (
 			yesFlag,
 			unitsFlag,
 			inclSrcDirNameFlag,
+			fileMinSizeFlag,
+			fileMaxSizeFlag,
+			fileNewerThanFlag,
+			fileOlderThanFlag,
 			skipVerCksumFlag,
+			putObjDfltCksumFlag,
+			putObjCksumTypeFlag,
 			continueOnErrorFlag, // TODO: revisit
 		),
 		cmdGenShards: {
@@ -102,7 +111,7 @@ var (
 		Name:         commandPut,
 		Usage:        archPutUsage,
 		ArgsUsage:    putApndArchArgument,
-		Flags:        archCmdsFlags[commandPut],
+		Flags:        append(archCmdsFlags[commandPut], putObjCksumFlags...),
 		Action:       putApndArchHandler,
 		BashComplete: putPromApndCompletions,
 	}
@@ -285,6 +294,16 @@ func putApndArchHandler(c *cli.Context) (err error) {
 	if flagIsSet(c, dryRunFlag) {
 		dryRunCptn(c)
 	}
+	if a.src.stdin {
+		if a.archpath == "" {
+			return fmt.Errorf("missing %s - required when archiving standard input", qflprn(archpathFlag))
+		}
+		if err = a2aStdin(c, &a); err != nil {
+			return
+		}
+		actionDone(c, fmt.Sprintf("%s standard input to %s as %q\n", a.verb(), a.dst.bck.Cname(a.dst.oname), a.archpath))
+		return
+	}
 	if a.srcIsRegular() {
 		// [convention]: naming default when '--archpath' is omitted
 		if a.archpath == "" {
@@ -370,16 +389,26 @@ func a2aRegular(c *cli.Context, a *archput) error {
 		// resulting message printed upon return
 		return nil
 	}
+	// archived file's checksum: validated and stored by the cluster (TAR formats)
+	cksum, err := cksumToCompute(c, a.dst.bck)
+	if err != nil {
+		return err
+	}
 	fh, err := cos.NewFileHandle(a.src.abspath)
 	if err != nil {
 		return err
 	}
+	fi, err := fh.Stat()
+	if err == nil {
+		// fail fast (before reading the file)
+		err = checkMaxObjSize(a.dst.bck, a.src.abspath, fi.Size())
+	}
+	if err != nil {
+		cos.Close(fh)
+		return err
+	}
 	reader = fh
 	if flagIsSet(c, progressFlag) {
-		fi, err := fh.Stat()
-		if err != nil {
-			return err
-		}
 		// setup progress bar
 		var (
 			bars []*mpb.Bar
@@ -394,20 +423,14 @@ func a2aRegular(c *cli.Context, a *archput) error {
 		Bck:        a.dst.bck,
 		ObjName:    a.dst.oname,
 		Reader:     reader,
-		Cksum:      nil,
+		Cksum:      cksum,
 		Size:       uint64(a.src.finfo.Size()),
 		SkipVC:     flagIsSet(c, skipVerCksumFlag),
 	}
 	putApndArchArgs := api.PutApndArchArgs{
 		PutArgs:  putArgs,
 		ArchPath: a.archpath,
-	}
-	if a.appendOnly {
-		putApndArchArgs.Flags = apc.ArchAppend
-	}
-	if a.appendOrPut {
-		debug.Assert(!a.appendOnly)
-		putApndArchArgs.Flags = apc.ArchAppendIfExist
+		Flags:    a.flags(),
 	}
 	err = api.PutApndArch(&putApndArchArgs)
 	if progress != nil {
@@ -416,6 +439,71 @@ func a2aRegular(c *cli.Context, a *archput) error {
 	return err
 }
 
+// the cluster rejects objects larger than the bucket's "max_objsize" (zero: unlimited);
+// (the resulting archive also includes the existing content and headers - hence, approximate)
+func checkMaxObjSize(bck cmn.Bck, fname string, size int64) error {
+	p, err := headBucket(bck, false /* don't add */)
+	if err != nil {
+		return err
+	}
+	if limit := int64(p.MaxObjSize); limit > 0 && size > limit {
+		return cmn.NewErrObjTooLarge(fname, size, limit)
+	}
+	return nil
+}
+
+// standard input => archive
+// (read it all: the cluster requires content length)
+func a2aStdin(c *cli.Context, a *archput) error {
+	if flagIsSet(c, dryRunFlag) {
+		return nil
+	}
+	cksum, err := cksumToCompute(c, a.dst.bck)
+	if err != nil {
+		return err
+	}
+	mm, err := memsys.NewMMSA("cli-arch-stdin", true /*silent*/)
+	if err != nil {
+		debug.AssertNoErr(err) // unlikely
+		return err
+	}
+	var (
+		ckh *cos.CksumHash
+		sgl = mm.NewSGL(0)
+		w   io.Writer
+	)
+	defer sgl.Free()
+	w = sgl
+	if cksum != nil && cksum.Val() == "" {
+		ckh = cos.NewCksumHash(cksum.Ty())
+		w = cos.NewWriterMulti(ckh.H, sgl)
+	}
+	if _, err := io.Copy(w, os.Stdin); err != nil {
+		return err
+	}
+	if sgl.Len() == 0 {
+		return errors.New("nothing to archive: standard input is empty")
+	}
+	if ckh != nil {
+		ckh.Finalize()
+		cksum = ckh.Clone()
+	}
+	putApndArchArgs := api.PutApndArchArgs{
+		PutArgs: api.PutArgs{
+			BaseParams: apiBP,
+			Bck:        a.dst.bck,
+			ObjName:    a.dst.oname,
+			Reader:     memsys.NewReader(sgl),
+			Cksum:      cksum,
+			Size:       uint64(sgl.Len()),
+			SkipVC:     flagIsSet(c, skipVerCksumFlag),
+		},
+		ArchPath: a.archpath,
+		Flags:    a.flags(),
+	}
+	return api.PutApndArch(&putApndArchArgs)
+}
+
 func getArchHandler(c *cli.Context) error {
 	return getHandler(c)
 }
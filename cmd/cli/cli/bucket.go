@@ -23,6 +23,7 @@ import (
 
 // Creates new ais bucket
 func createBucket(c *cli.Context, bck cmn.Bck, props *cmn.BpropsToSet, dontHeadRemote bool) (err error) {
+	bpCache.del(bck)
 	if err = api.CreateBucket(apiBP, bck, props, dontHeadRemote); err != nil {
 		if herr, ok := err.(*cmn.ErrHTTP); ok {
 			if herr.Status == http.StatusConflict {
@@ -58,6 +59,7 @@ func destroyBuckets(c *cli.Context, buckets []cmn.Bck) (cmn.Bck, error) {
 			}
 		}
 
+		bpCache.del(bck)
 		err := api.DestroyBucket(apiBP, bck)
 		if err == nil {
 			fmt.Fprintf(c.App.Writer, "%q destroyed\n", bck.Cname(""))
@@ -81,6 +83,7 @@ func mvBucket(c *cli.Context, bckFrom, bckTo cmn.Bck) error {
 	if _, err := headBucket(bckFrom, true /* don't add */); err != nil {
 		return err
 	}
+	bpCache.del(bckFrom, bckTo)
 	xid, err := api.RenameBucket(apiBP, bckFrom, bckTo)
 	if err != nil {
 		return V(err)
@@ -156,13 +159,14 @@ func _evictBck(c *cli.Context, bck cmn.Bck) (err error) {
 		return err
 	}
 	keep := flagIsSet(c, keepMDFlag)
+	bpCache.del(bck)
 	if err = api.EvictRemoteBucket(apiBP, bck, keep); err != nil {
 		return V(err)
 	}
 	if !keep {
 		actionDone(c, "Evicted bucket "+bck.Cname("")+" from aistore")
 	} else {
-		actionDone(c, "Evicted "+bck.Cname("")+" contents from aistore: the bucket is now empty")
+		actionDone(c, "Evicted "+bck.Cname("")+" contents from aistore: the bucket is now empty (bucket metadata retained)")
 	}
 	return nil
 }
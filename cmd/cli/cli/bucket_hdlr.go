@@ -63,6 +63,7 @@ var copyBucketUsage = "copy entire bucket or selected objects (to select, use '-
 	indent1 + "\t- 'ais cp s3://abc ais://nnn --sync'\t- same as above, but in addition delete in-cluster copies that do not exist (any longer) in the remote source\n" +
 	indent1 + "with template, prefix, and/or progress bar:\n" +
 	indent1 + "\t- 'ais cp ais://nnn/111 ais://mmm'\t- copy a single object (assuming, prefix '111' corresponds to a single object);\n" +
+	indent1 + "\t- 'ais cp ais://nnn/111 ais://mmm/222'\t- server-side copy of a single object 'ais://nnn/111' named 'ais://mmm/222' at the destination;\n" +
 	indent1 + "\t- 'ais cp gs://webdataset-coco ais:/dst --template d-tokens/shard-{000000..000999}.tar.lz4'\t- copy up to 1000 objects that share the specified prefix;\n" +
 	indent1 + "\t- 'ais cp gs://webdataset-coco ais:/dst --prefix d-tokens/ --progress --all'\t- show progress while copying virtual subdirectory 'd-tokens'"
 
@@ -88,10 +89,22 @@ var (
 			forceFlag,
 			copyDryRunFlag,
 			copyPrependFlag,
+			copyPrefixReplaceFlag,
+			copyPreCountFlag,
+			copyRegexFlag,
+			copyFilterCustomFlag,
+			copyMaxBWFlag,
+			copyCheckpointFlag,
+			copyResumeFlag,
+			copyCompressionFlag,
+			copyTranscodeFlag,
+			copyNumWorkersFlag,
+			copyBatchSizeFlag,
 			progressFlag,
 			refreshFlag,
 			waitFlag,
 			waitJobXactFinishedFlag,
+			copyAbortOnTimeoutFlag,
 			latestVerFlag,
 			syncFlag,
 			nonverboseFlag,
@@ -108,6 +121,7 @@ var (
 			dryRunFlag,
 			verboseFlag, // not yet used
 			nonverboseFlag,
+			continueOnErrorFlag,
 		),
 		cmdSetBprops: {
 			forceFlag,
@@ -141,6 +155,9 @@ var (
 			dontWaitFlag,
 			verChangedFlag,
 			useInventoryFlag,
+			lsS3Flag,
+			lsS3DelimiterFlag,
+			lsS3URLEncodingFlag,
 		},
 
 		cmdLRU: {
@@ -419,6 +436,7 @@ func resetPropsHandler(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	bpCache.del(bck)
 	if _, err := api.ResetBucketProps(apiBP, bck); err != nil {
 		return V(err)
 	}
@@ -517,6 +535,7 @@ func updateBckProps(c *cli.Context, bck cmn.Bck, currProps *cmn.Bprops, updatePr
 	}
 
 	// do
+	bpCache.del(bck)
 	if _, err = api.SetBucketProps(apiBP, bck, updateProps); err != nil {
 		if herr, ok := err.(*cmn.ErrHTTP); ok && herr.Status == http.StatusNotFound {
 			return herr
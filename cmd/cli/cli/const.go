@@ -64,10 +64,12 @@ const (
 	commandPut       = "put"
 	commandRemove    = "rm"
 	commandRename    = "mv"
+	commandRestore   = "restore"
 	commandSet       = "set"
 	commandStart     = apc.ActXactStart
 	commandStop      = apc.ActXactStop
 	commandWait      = "wait"
+	commandSetMaxBW  = "set-max-bw"
 
 	cmdSmap   = apc.WhatSmap
 	cmdBMD    = apc.WhatBMD
@@ -193,6 +195,15 @@ const (
 
 const (
 	dfltStdinChunkSize = 10 * cos.MiB
+
+	// GET multiple objects
+	dfltGetMultiWorkers = 4
+	maxGetMultiWorkers  = 128
+
+	// PUT (large) file via multipart upload
+	dfltPutMptWorkers = 4
+	maxPutMptWorkers  = 128
+	maxPutMptParts    = 10000 // (compare w/ s3.MaxPartsPerUpload)
 )
 
 const (
@@ -218,6 +229,7 @@ const (
 	optionalJobIDDaemonIDArgument = "[JOB_ID [NODE_ID]]"
 
 	jobAnyArg                = "[NAME] [JOB_ID] [NODE_ID] [BUCKET]"
+	jobMaxBWArgument         = "JOB_ID BANDWIDTH"
 	jobShowRebalanceArgument = "[REB_ID] [NODE_ID]"
 
 	// Perf
@@ -394,6 +406,18 @@ var (
 			indent4 + "\ta/b that have their names (relative to this directory) starting with 'c';\n" +
 			indent4 + "\t'--prefix \"\"' - get entire bucket (all objects)",
 	}
+	getArchFormatFlag = cli.StringFlag{
+		Name: "archive-format",
+		Usage: "when getting multiple objects to standard output ('-'): format of the resulting archive (stream)\n" +
+			indent4 + "\tone of: tar (default), tgz, zip; each object becomes an archived file named by its object name, e.g.:\n" +
+			indent4 + "\t'ais get ais://nnn --prefix images/ - --archive-format tgz > images.tgz'",
+	}
+	getToDirFlag = cli.StringFlag{
+		Name: "to-dir",
+		Usage: "write objects into the specified destination directory, preserving object names' hierarchy, e.g.:\n" +
+			indent4 + "\t'ais get ais://nnn --prefix a/b --to-dir /tmp/out' - writes object a/b/c/d as /tmp/out/a/b/c/d\n" +
+			indent4 + "\t(subdirectories are created as needed; object names containing '..' are rejected)",
+	}
 	verbObjPrefixFlag = cli.StringFlag{
 		Name: "prefix",
 		Usage: "select objects that have names starting with the specified prefix, e.g.:\n" +
@@ -531,6 +555,19 @@ var (
 		Name:  "inventory",
 		Usage: "experimental; requires s3:// backend",
 	}
+	lsS3Flag = cli.BoolFlag{
+		Name: "s3",
+		Usage: "list objects via the cluster's S3 API (ListObjectsV2) and show the response as is (for parity testing);\n" +
+			indent4 + "\tsupports: --prefix, --start-after, --page-size (S3 max-keys), --max-pages, --delimiter, and --url-encoding",
+	}
+	lsS3DelimiterFlag = cli.StringFlag{
+		Name:  "delimiter",
+		Usage: "(with --s3) group names that contain the delimiter after the prefix into S3 common prefixes, e.g. '/'",
+	}
+	lsS3URLEncodingFlag = cli.BoolFlag{
+		Name:  "url-encoding",
+		Usage: "(with --s3) request URL-encoded object names (S3 'encoding-type=url')",
+	}
 
 	keepMDFlag       = cli.BoolFlag{Name: "keep-md", Usage: "keep bucket metadata"}
 	dataSlicesFlag   = cli.IntFlag{Name: "data-slices,data,d", Usage: "number of data slices", Required: true}
@@ -618,6 +655,82 @@ var (
 		Value: 10,
 		Usage: "limits number of concurrent put requests and number of concurrent shards created",
 	}
+	putRetriesFlag = cli.IntFlag{
+		Name: "retries",
+		Usage: "when a given PUT fails with a retriable error (e.g., timeout, 5xx, connection reset), retry up to so many times;\n" +
+			indent4 + "\tnon-retriable errors (e.g., 400 Bad Request, checksum mismatch) fail immediately (see also: '--retry-backoff')",
+	}
+	putRetryBackoffFlag = DurationFlag{
+		Name: "retry-backoff",
+		Usage: "initial time to wait between retries - doubles with each subsequent retry (see also: '--retries');\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+		Value: time.Second,
+	}
+
+	flatFlag = cli.BoolFlag{
+		Name: "flat",
+		Usage: "flatten local directory structure: use only the base name of each source file as the destination name\n" +
+			indent4 + "	(optionally, prefixed with the destination prefix); fail if two files would map to the same name",
+	}
+
+	concatPipelineFlag = cli.BoolFlag{
+		Name: "pipeline",
+		Usage: "read ahead: open (and pre-read, if not larger than 64MiB) the next file while appending the current one\n" +
+			indent4 + "\t(files are still appended strictly in order; useful when composing from hundreds of parts)",
+	}
+	concatOrderByFlag = cli.StringFlag{
+		Name: "order-by",
+		Usage: "order in which files from each source directory get appended:\n" +
+			indent4 + "\t'name'    - lexicographic (default), e.g.: part1, part10, part2;\n" +
+			indent4 + "\t'natural' - numeric-aware, e.g.: part1, part2, part10;\n" +
+			indent4 + "\t'mtime'   - by modification time, oldest first;\n" +
+			indent4 + "\t'size'    - by size, smallest first",
+		Value: orderByName,
+	}
+
+	rmOlderThanFlag = cli.StringFlag{
+		Name: "older-than",
+		Usage: "remove only those objects that were last accessed before the specified time, e.g.:\n" +
+			indent4 + "\t'--older-than 720h' - not accessed in the last 30 days;\n" +
+			indent4 + "\t'--older-than 2024-01-02T15:04:05Z' - RFC3339 timestamp;\n" +
+			indent4 + "\tcan be used together with '--prefix'",
+	}
+
+	skipExistingFlag = cli.BoolFlag{
+		Name: "skip-existing",
+		Usage: "skip files that already exist in the destination bucket with the same size;\n" +
+			indent4 + "\twith '--compute-checksum', also compare (and require matching) checksums;\n" +
+			indent4 + "\tthe destination is listed once (by the longest common prefix) rather than HEAD-ed object by object",
+	}
+
+	putStoreMD5Flag = cli.BoolFlag{
+		Name: "compute-and-store-md5",
+		Usage: "compute MD5 of each object's content while writing it and store it as the object's custom property \"md5\"\n" +
+			indent4 + "\t(to show, run 'ais ls BUCKET --props custom'); not supported when appending (including standard input\n" +
+			indent4 + "\tand multipart upload) and cannot be combined with other checksum options (e.g., '--crc32c', '--cksum-type')",
+	}
+
+	// local files: filter (source) files when walking directories
+	fileMinSizeFlag = cli.StringFlag{
+		Name: "min-size",
+		Usage: "skip (source) files smaller than the specified size, e.g.: 4KB, 1MiB, etc.\n" +
+			indent4 + "	(see also: '--units')",
+	}
+	fileMaxSizeFlag = cli.StringFlag{
+		Name: "max-size",
+		Usage: "skip (source) files larger than the specified size, e.g.: 100MB, 1GiB, etc.\n" +
+			indent4 + "	(see also: '--units')",
+	}
+	fileNewerThanFlag = DurationFlag{
+		Name: "newer-than",
+		Usage: "include only (source) files modified within the specified duration, e.g.: '--newer-than 24h';\n" +
+			indent4 + "	valid time units: " + timeUnits,
+	}
+	fileOlderThanFlag = DurationFlag{
+		Name: "older-than",
+		Usage: "include only (source) files modified earlier than the specified duration ago, e.g.: '--older-than 168h';\n" +
+			indent4 + "	valid time units: " + timeUnits,
+	}
 
 	// waiting
 	waitPodReadyTimeoutFlag = DurationFlag{
@@ -673,11 +786,13 @@ var (
 
 	// read range (aka range read)
 	offsetFlag = cli.StringFlag{
-		Name:  "offset",
-		Usage: "object read offset; must be used together with '--length'; default formatting: IEC (use '--units' to override)"}
+		Name: "offset",
+		Usage: "object read offset; must be used together with '--length'; default formatting: IEC (use '--units' to override);\n" +
+			indent4 + "\tcomma-separated list to read multiple (non-overlapping) ranges, e.g.: '--offset 0,1MiB --length 100,4KiB'"}
 	lengthFlag = cli.StringFlag{
-		Name:  "length",
-		Usage: "object read length; default formatting: IEC (use '--units' to override)",
+		Name: "length",
+		Usage: "object read length; default formatting: IEC (use '--units' to override);\n" +
+			indent4 + "\tcomma-separated list to read multiple ranges (one length per '--offset')",
 	}
 
 	// NOTE:
@@ -738,9 +853,14 @@ var (
 	disableFlag = cli.BoolFlag{Name: "disable", Usage: "disable"}
 	recursFlag  = cli.BoolFlag{Name: "recursive,r", Usage: "recursive operation"}
 
-	overwriteFlag = cli.BoolFlag{Name: "overwrite-dst,o", Usage: "overwrite destination, if exists"}
-	deleteSrcFlag = cli.BoolFlag{Name: "delete-src", Usage: "delete successfully promoted source"}
-	targetIDFlag  = cli.StringFlag{Name: "target-id", Usage: "ais target designated to carry out the entire operation"}
+	overwriteFlag    = cli.BoolFlag{Name: "overwrite-dst,o", Usage: "overwrite destination, if exists"}
+	deleteSrcFlag    = cli.BoolFlag{Name: "delete-src", Usage: "delete successfully promoted source"}
+	verifyDeleteFlag = cli.BoolFlag{
+		Name: "verify-delete",
+		Usage: "wait for the promotion to finish, report the number of promoted objects (as per job stats),\n" +
+			indent4 + "\tand warn if any source files remain (requires '--delete-src')",
+	}
+	targetIDFlag = cli.StringFlag{Name: "target-id", Usage: "ais target designated to carry out the entire operation"}
 
 	notFshareFlag = cli.BoolFlag{
 		Name: "not-file-share",
@@ -748,9 +868,15 @@ var (
 			"(as seen from the target)",
 	}
 
+	promoteCksumFlag = cli.BoolFlag{
+		Name: "compute-checksum",
+		Usage: "compute and store bucket-configured checksum (and MD5-based S3 ETag) of each promoted file\n" +
+			indent4 + "\tas part of the promotion (the default is to use precomputed checksum, if available)",
+	}
+
 	yesFlag = cli.BoolFlag{Name: "yes,y", Usage: "assume 'yes' to all questions"}
 
-	// usage: STDIN, blob
+	// usage: STDIN, blob, multipart upload
 	chunkSizeFlag = cli.StringFlag{
 		Name:  "chunk-size",
 		Usage: "chunk size in IEC or SI units, or \"raw\" bytes (e.g.: 4mb, 1MiB, 1048576, 128k; see '--units')",
@@ -772,8 +898,65 @@ var (
 		Usage: "number of concurrent blob-downloading workers (readers); system default when omitted or zero",
 	}
 
+	getNumWorkersFlag = cli.IntFlag{
+		Name: numWorkersFlag.Name,
+		Usage: "number of concurrent workers:\n" +
+			indent4 + "\t- when getting multiple objects (via '--prefix', '--list', or '--template'): number of concurrent GETs\n" +
+			indent4 + "\t  (default 4);\n" +
+			indent4 + "\t- with '--blob-download': number of concurrent blob-downloading workers (readers); system default when omitted or zero",
+	}
+
+	rmNumWorkersFlag = cli.IntFlag{
+		Name:  numWorkersFlag.Name,
+		Usage: "number of concurrent DELETE requests when removing all objects with '--all' (default: number of CPUs)",
+	}
+
+	putNumWorkersFlag = cli.IntFlag{
+		Name: numWorkersFlag.Name,
+		Usage: "number of concurrent workers uploading parts of a large file when using multipart upload\n" +
+			indent4 + "\t(see '--chunk-size'; default 4)",
+	}
+
 	cksumFlag = cli.BoolFlag{Name: "checksum", Usage: "validate checksum"}
 
+	// TODO: per-chunk checksums (not stored yet)
+	chunkValidateFlag = cli.BoolFlag{
+		Name: "chunk-validate",
+		Usage: "validate checksums chunk by chunk while receiving the object, to fail fast on the first bad chunk;\n" +
+			indent4 + "\tnote: per-chunk checksums are not currently stored - falls back to whole-object validation ('--checksum')",
+	}
+
+	getRetryCksumFlag = cli.IntFlag{
+		Name: "retry-on-cksum-mismatch",
+		Usage: "upon checksum mismatch, remove the (bad) destination file and GET again, up to so many times\n" +
+			indent4 + "\t(e.g., to get served from a different replica or via EC reconstruction); implies '--checksum';\n" +
+			indent4 + "\tall other errors fail immediately",
+	}
+
+	decompressFlag = cli.BoolFlag{
+		Name: "decompress",
+		Usage: "decompress gzip or zstd compressed object while downloading it (the format is detected by content);\n" +
+			indent4 + "\tthe reported size is the size of decompressed output",
+	}
+	headFirstFlag = cli.BoolFlag{
+		Name: "head-first",
+		Usage: "execute HEAD(object) prior to GET, to fail fast (with no destination file created) if the object does not exist\n" +
+			indent4 + "\t(costs an extra round trip - not done by default)",
+	}
+
+	checksumOnlyFlag = cli.BoolFlag{
+		Name: "checksum-only",
+		Usage: "read the object (or its range, via --offset and --length), validate checksum, and discard the content\n" +
+			indent4 + "\t(does not write to disk; returns non-zero exit status upon checksum mismatch)",
+	}
+
+	resumeFlag = cli.BoolFlag{
+		Name: "resume",
+		Usage: "resume interrupted download: write to OUT_FILE.part and, upon restart, read the remaining bytes\n" +
+			indent4 + "\t(the download restarts from zero if the object has changed in the meantime);\n" +
+			indent4 + "\tdownloads the entire object - cannot be used with '--offset' and '--length'",
+	}
+
 	putObjCksumText     = indent4 + "\tand provide it as part of the PUT request for subsequent validation on the server side"
 	putObjCksumFlags    = initPutObjCksumFlags()
 	putObjDfltCksumFlag = cli.BoolFlag{
@@ -781,11 +964,33 @@ var (
 		Usage: "[end-to-end protection] compute client-side checksum configured for the destination bucket\n" +
 			putObjCksumText,
 	}
+	putObjCksumTypeFlag = cli.StringFlag{
+		Name: "cksum-type",
+		Usage: "compute client-side checksum of the specified type (e.g. 'md5') regardless of the bucket's configured type\n" +
+			putObjCksumText,
+	}
 
 	appendConcatFlag = cli.BoolFlag{
 		Name:  "append",
 		Usage: "concatenate files: append a file or multiple files as a new _or_ to an existing object",
 	}
+	putAppendIfExistsFlag = cli.BoolFlag{
+		Name: "append-if-exists",
+		Usage: "if destination object exists append the source (file or standard input) to it, otherwise PUT a new one;\n" +
+			indent4 + "\tnote: not atomic - when racing with concurrent writers the last writer wins",
+	}
+
+	putMmapFlag = cli.BoolFlag{
+		Name: "mmap",
+		Usage: "read the source file via memory mapping (may reduce CPU usage when uploading large local files);\n" +
+			indent4 + "\tapplies to single-file PUT; falls back to regular reads if the file cannot be mapped",
+	}
+
+	putTTLFlag = cli.DurationFlag{
+		Name: "ttl",
+		Usage: "object's time-to-live, e.g. '30m', '24h': store the resulting expiration time as the object's\n" +
+			indent4 + "\tcustom property \"expires-at\" (to show remaining time, run 'ais object show BUCKET/OBJECT')",
+	}
 
 	skipVerCksumFlag = cli.BoolFlag{
 		Name:  "skip-vc",
@@ -810,8 +1015,15 @@ var (
 		Usage: "filename in archive (shard)",
 	}
 	archpathGetFlag = cli.StringFlag{
-		Name:  archpathFlag.Name,
-		Usage: "extract the specified file from an archive (shard)",
+		Name: archpathFlag.Name,
+		Usage: "extract the specified file from an archive (shard);\n" +
+			indent4 + "\twith '--glob': glob patterns and brace expansion, e.g.: --archpath 'data/*.{json,txt}' --glob\n" +
+			indent4 + "\t(when multiple files match, destination must be a directory)",
+	}
+	archGlobFlag = cli.BoolFlag{
+		Name: "glob",
+		Usage: "interpret '--archpath' as a glob pattern (with brace expansion) to extract all matching files;\n" +
+			indent4 + "\twithout this flag, '--archpath' is a literal filename, special characters ('*', '?', '[', '{') included",
 	}
 	extractFlag = cli.BoolFlag{
 		Name:  "extract,x",
@@ -839,8 +1051,11 @@ var (
 	}
 
 	continueOnErrorFlag = cli.BoolFlag{
-		Name:  "cont-on-err",
-		Usage: "keep running archiving xaction (job) in presence of errors in a any given multi-object transaction",
+		Name: "cont-on-err",
+		Usage: "keep running in presence of errors, e.g.:\n" +
+			indent4 + "\t- archiving xaction (job): errors in any given multi-object transaction;\n" +
+			indent4 + "\t- multiple command-line arguments (e.g., 'ais rm ais://nnn/a ais://nnn/b'): process all of them,\n" +
+			indent4 + "\t  and summarize failures at the end",
 	}
 	// end archive
 
@@ -859,6 +1074,67 @@ var (
 		Name:  "dry-run",
 		Usage: "show total size of new objects without really creating them",
 	}
+	copyPreCountFlag = cli.BoolFlag{
+		Name: "pre-count",
+		Usage: "count source objects prior to copying, to report progress (total vs. processed, percentage) via 'ais show job';\n" +
+			indent4 + "\tapplies to objects present in the cluster (the count may take a while for very large buckets)",
+	}
+	copyMaxBWFlag = cli.StringFlag{
+		Name: "max-bw",
+		Usage: "limit bucket-to-bucket copying bandwidth (bytes per second, per target), e.g.: '--max-bw 100MiB';\n" +
+			indent4 + "\tthe limit can be subsequently changed (or removed) while the job is running - see 'ais job set-max-bw'",
+	}
+	copyNumWorkersFlag = cli.IntFlag{
+		Name: numWorkersFlag.Name,
+		Usage: "multi-object copy ('--list', '--template'): number of concurrent copying workers per target (max 64);\n" +
+			indent4 + "\tomitted, zero, or one: copy source objects one at a time",
+	}
+	copyBatchSizeFlag = cli.IntFlag{
+		Name: "batch-size",
+		Usage: "multi-object copy ('--list', '--template' with ranges): split source names into batches of (at most) the\n" +
+			indent4 + "\tspecified size and start one job per batch, to run concurrently;\n" +
+			indent4 + "\twith '--progress' (or '--wait'), show aggregated progress and combined final count",
+	}
+	copyCompressionFlag = cli.StringFlag{
+		Name: "compression",
+		Usage: "compress bucket-to-bucket intra-cluster traffic at the specified level (overrides 'tcb.compression'), one of:\n" +
+			indent4 + "\t'fast' - default lz4;\n" +
+			indent4 + "\t'high' - lz4 HC: higher compression ratio at the cost of (sender's) CPU, e.g. for WAN copies",
+	}
+	copyTranscodeFlag = cli.StringFlag{
+		Name: "transcode",
+		Usage: "convert source objects while copying, one of:\n" +
+			indent4 + "\t'tar2tgz' - gzip-compress '.tar' objects and store them with '.tgz' extension;\n" +
+			indent4 + "\tall other objects are copied as is",
+	}
+	copyAbortOnTimeoutFlag = cli.BoolFlag{
+		Name: "abort-on-timeout",
+		Usage: "when waiting for the copy job to finish ('--wait' and '--timeout') and the timeout expires,\n" +
+			indent4 + "\tabort the job (default: leave it running and fail only the command)",
+	}
+	copyCheckpointFlag = cli.BoolFlag{
+		Name: "checkpoint",
+		Usage: "bucket-to-bucket copy: persist progress checkpoints (and copy source objects in sorted order),\n" +
+			indent4 + "\tso that the job, if aborted, could be resumed (see '--resume')",
+	}
+	copyResumeFlag = cli.StringFlag{
+		Name: "resume",
+		Usage: "resume previously aborted bucket-to-bucket copy job (given its ID) from the job's checkpoint,\n" +
+			indent4 + "\tskipping source objects that have already been copied, e.g.: '--resume JOB_ID'\n" +
+			indent4 + "\t(the aborted job must have been started with '--checkpoint', or resumed itself)",
+	}
+	copyRegexFlag = cli.StringFlag{
+		Name: regexFlag.Name,
+		Usage: "copy only those source objects that match the regular expression, e.g.:\n" +
+			indent4 + "\t--regex '.*\\.parquet$'\t- copy parquet files only;\n" +
+			indent4 + "\t(applies to bucket-to-bucket copy and can be combined with '--prefix')",
+	}
+	copyFilterCustomFlag = cli.StringFlag{
+		Name: "filter-custom",
+		Usage: "copy only those source objects that have the specified custom property, e.g.:\n" +
+			indent4 + "\t--filter-custom status=ready\t- copy objects with custom property \"status\" set to \"ready\";\n" +
+			indent4 + "\t(applies to bucket-to-bucket copy and can be combined with '--prefix' and '--regex')",
+	}
 	copyPrependFlag = cli.StringFlag{
 		Name: "prepend",
 		Usage: "prefix to prepend to every copied object name, e.g.:\n" +
@@ -866,6 +1142,13 @@ var (
 			indent4 + "\t--prepend=abc/\t- copy objects into a virtual directory \"abc\" (note trailing filepath separator)",
 	}
 
+	copyPrefixReplaceFlag = cli.StringFlag{
+		Name: "prefix-replace",
+		Usage: "replace source object name prefix in the destination name, e.g.:\n" +
+			indent4 + "\t--prefix-replace=old/,new/\t- copy \"old/a.txt\" as \"new/a.txt\" (names that don't start with \"old/\" remain unchanged);\n" +
+			indent4 + "\tis applied prior to '--prepend', if both specified",
+	}
+
 	// ETL
 	etlExtFlag  = cli.StringFlag{Name: "ext", Usage: "mapping from old to new extensions of transformed objects' names"}
 	etlNameFlag = cli.StringFlag{
@@ -873,6 +1156,11 @@ var (
 		Usage:    "unique ETL name (leaving this field empty will have unique ID auto-generated)",
 		Required: true,
 	}
+	etlNumWorkersFlag = cli.IntFlag{
+		Name: numWorkersFlag.Name,
+		Usage: "number of concurrent transformations per mountpath (overrides cluster configuration 'tcb.parallelism');\n" +
+			indent4 + "\tomitted or zero: use configuration or, if not configured, auto-tune based on mountpath utilization",
+	}
 	etlBucketRequestTimeout = DurationFlag{
 		Name: "etl-timeout",
 		Usage: "server-side timeout transforming a single object;\n" +
@@ -953,6 +1241,16 @@ var (
 		Name:  "set-new-custom",
 		Usage: "remove existing custom keys (if any) and store new custom metadata",
 	}
+	setCustomNumWorkersFlag = cli.IntFlag{
+		Name:  numWorkersFlag.Name,
+		Usage: "number of concurrent requests when updating multiple objects ('--list', '--template'); default 4",
+	}
+	setCustomFromFileFlag = cli.StringFlag{
+		Name: "from-file",
+		Usage: "read custom properties from a file (use '-' for standard input), e.g.:\n" +
+			indent4 + "\t--from-file props.json\t- JSON object, e.g. {\"status\": \"ready\", \"source\": \"catalog\"};\n" +
+			indent4 + "\t--from-file props.csv\t- CSV, one key,value per line (optional header 'key,value')",
+	}
 
 	cliConfigPathFlag = cli.BoolFlag{
 		Name:  "path",
@@ -12,6 +12,7 @@ import (
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
@@ -23,6 +24,9 @@ type cprCtx struct {
 	barObjs *mpb.Bar
 	barSize *mpb.Bar
 	xid     string
+	xids    []string // when aggregating progress across multiple jobs (see tcoBatches)
+	kind    string   // (ditto - jobs are queried by kind and bucket)
+	bck     cmn.Bck
 	from    string // from-bucket name or _the_ bucket name
 	to      string // to-bucket name (optional)
 	xname   string
@@ -131,7 +135,11 @@ func (cpr *cprCtx) multiobj(c *cli.Context, text string) (err error) {
 	// 4. done
 	err = <-cpr.errCh
 	if err == nil {
-		actionDone(c, fmtXactSucceeded)
+		if len(cpr.xids) > 0 {
+			actionDone(c, tcoBatchesCptn(cpr.objs, cpr.size, len(cpr.xids)))
+		} else {
+			actionDone(c, fmtXactSucceeded)
+		}
 	}
 	close(cpr.errCh)
 	return
@@ -149,6 +157,9 @@ func (cpr *cprCtx) do(c *cli.Context) {
 		totalWait time.Duration
 		xargs     = xact.ArgsMsg{ID: cpr.xid}
 	)
+	if len(cpr.xids) > 0 {
+		xargs = xact.ArgsMsg{Kind: cpr.kind, Bck: cpr.bck}
+	}
 outer:
 	for {
 		var (
@@ -165,9 +176,15 @@ outer:
 			break
 		}
 		for _, snaps := range xs {
-			debug.Assert(len(snaps) < 2)
+			debug.Assert(len(snaps) < 2 || len(cpr.xids) > 0)
 			for _, xsnap := range snaps {
-				debug.Assertf(cpr.xid == xsnap.ID, "%q vs %q", cpr.xid, xsnap.ID)
+				if len(cpr.xids) > 0 {
+					if !cos.StringInSlice(xsnap.ID, cpr.xids) {
+						continue // other job of the same kind
+					}
+				} else {
+					debug.Assertf(cpr.xid == xsnap.ID, "%q vs %q", cpr.xid, xsnap.ID)
+				}
 				size += xsnap.Stats.Bytes
 				objs += xsnap.Stats.Objs
 				if xsnap.IsAborted() {
@@ -181,7 +198,9 @@ outer:
 						nrun++
 					}
 				}
-				break // expecting one from target
+				if len(cpr.xids) == 0 {
+					break // expecting one from target
+				}
 			}
 		}
 		cpr.updObjs(objs)
@@ -209,6 +228,9 @@ outer:
 		}
 		if cpr.timeout != 0 && totalWait > cpr.timeout {
 			rerr = fmt.Errorf("%s: timeout %v (%s)", cpr.loghdr, cpr.timeout, cpr.log())
+			if flagIsSet(c, copyAbortOnTimeoutFlag) {
+				rerr = fmt.Errorf("%v: %v", rerr, cpr.timedOut(c))
+			}
 			break
 		}
 	}
@@ -222,6 +244,19 @@ outer:
 	}
 }
 
+// (one or all jobs)
+func (cpr *cprCtx) timedOut(c *cli.Context) error {
+	if len(cpr.xids) == 0 {
+		return copyTimedOut(c, cpr.xid, cpr.xname, cpr.timeout)
+	}
+	var errs cos.Errs
+	for _, xid := range cpr.xids {
+		errs.Add(copyTimedOut(c, xid, cpr.kind, cpr.timeout))
+	}
+	_, err := errs.JoinErr()
+	return err
+}
+
 func (cpr *cprCtx) updObjs(objs int64) {
 	if objs <= cpr.objs {
 		return
@@ -0,0 +1,252 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	ratomic "sync/atomic"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/urfave/cli"
+)
+
+// 'ais object set-custom':
+// - '--from-file': load custom properties from a JSON or CSV file,
+//   e.g. when migrating object metadata from an external catalog
+// - '--list', '--template': set the same properties on multiple objects
+//   (client side, with a limited number of concurrent requests)
+
+// custom properties are stored with other object metadata in a single xattr that must not exceed
+// 4KiB (see core/lom_xattr.go) - hence, the (client-side) budget
+const maxCustomPropsSize = 3 * cos.KiB
+
+func loadCustomProps(fname string) (cos.StrKVs, error) {
+	var (
+		b   []byte
+		err error
+	)
+	if fname == fileStdIO {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(cos.ExpandPath(fname))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var props cos.StrKVs
+	switch ext := strings.ToLower(filepath.Ext(fname)); {
+	case ext == ".json":
+		props, err = parseCustomJSON(b)
+	case ext == ".csv":
+		props, err = parseCustomCSV(b)
+	default:
+		// sniff
+		if t := bytes.TrimSpace(b); len(t) > 0 && t[0] == '{' {
+			props, err = parseCustomJSON(b)
+		} else {
+			props, err = parseCustomCSV(b)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", fname, err)
+	}
+	if len(props) == 0 {
+		return nil, fmt.Errorf("%s: no custom properties", fname)
+	}
+	var size int
+	for k, v := range props {
+		size += len(k) + len(v)
+	}
+	if size > maxCustomPropsSize {
+		return nil, fmt.Errorf("%s: custom properties are too large (%d keys, total size %s > %s)",
+			fname, len(props), cos.ToSizeIEC(int64(size), 0), cos.ToSizeIEC(maxCustomPropsSize, 0))
+	}
+	return props, nil
+}
+
+func addCustomProp(props cos.StrKVs, key, value string) error {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return errors.New("empty key")
+	}
+	if _, ok := props[key]; ok {
+		return fmt.Errorf("duplicate key %q", key)
+	}
+	props[key] = strings.TrimSpace(value)
+	return nil
+}
+
+// JSON object of scalar values; unlike json.Unmarshal, fails on duplicate keys
+func parseCustomJSON(b []byte) (cos.StrKVs, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, errors.New("expecting JSON object")
+	}
+	props := make(cos.StrKVs)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key := tok.(string) // (object keys are always strings)
+		if tok, err = dec.Token(); err != nil {
+			return nil, err
+		}
+		var value string
+		switch v := tok.(type) {
+		case string:
+			value = v
+		case json.Number:
+			value = v.String()
+		case bool:
+			value = fmt.Sprint(v)
+		default:
+			return nil, fmt.Errorf("key %q: expecting string, number, or boolean value", key)
+		}
+		if err := addCustomProp(props, key, value); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return props, nil
+}
+
+// one key,value per line; empty lines and lines starting with '#' are skipped
+func parseCustomCSV(b []byte) (cos.StrKVs, error) {
+	r := csv.NewReader(bytes.NewReader(b))
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > 0 && strings.EqualFold(records[0][0], "key") && strings.EqualFold(records[0][1], "value") {
+		records = records[1:] // header
+	}
+	props := make(cos.StrKVs, len(records))
+	for _, rec := range records {
+		if err := addCustomProp(props, rec[0], rec[1]); err != nil {
+			return nil, err
+		}
+	}
+	return props, nil
+}
+
+//
+// multiple objects
+//
+
+func setCustomPropsMulti(c *cli.Context, bck cmn.Bck, props cos.StrKVs, setNewCustom bool) error {
+	numWorkers := dfltGetMultiWorkers // (same defaults as multi-object GET)
+	if flagIsSet(c, setCustomNumWorkersFlag) {
+		numWorkers = parseIntFlag(c, setCustomNumWorkersFlag)
+		if numWorkers <= 0 || numWorkers > maxGetMultiWorkers {
+			return fmt.Errorf("invalid %s=%d: expecting (1..%d) range", qflprn(setCustomNumWorkersFlag), numWorkers, maxGetMultiWorkers)
+		}
+	}
+	names, prefix, listed, err := _setCustomNames(c, bck)
+	if err != nil {
+		return err
+	}
+	l := len(names)
+	if l == 0 {
+		fmt.Fprintln(c.App.Writer, "No matching objects in", bck.Cname(""))
+		return nil
+	}
+
+	// (listed) all objects or all objects with a given prefix
+	if listed && !flagIsSet(c, yesFlag) {
+		if prefix == "" {
+			return fmt.Errorf("empty %s selects all %d objects in %s - to proceed, specify %s",
+				qflprn(templateFlag), l, bck.Cname(""), qflprn(yesFlag))
+		}
+		if ok := confirm(c, fmt.Sprintf("Update custom props of %d object%s with prefix %q in %s?",
+			l, cos.Plural(l), prefix, bck.Cname(""))); !ok {
+			return nil
+		}
+	}
+
+	var (
+		cnt, errCnt int64
+		contOnErr   = flagIsSet(c, continueOnErrorFlag)
+		wg          = cos.NewLimitedWaitGroup(numWorkers, l)
+	)
+	for _, name := range names {
+		if !contOnErr && ratomic.LoadInt64(&errCnt) > 0 {
+			break // (the first failure cancels the rest)
+		}
+		wg.Add(1)
+		go func(objName string) {
+			if err := api.SetObjectCustomProps(apiBP, bck, objName, props, setNewCustom); err != nil {
+				ratomic.AddInt64(&errCnt, 1)
+				actionWarn(c, fmt.Sprintf("%s: %v", bck.Cname(objName), err))
+			} else {
+				ratomic.AddInt64(&cnt, 1)
+			}
+			wg.Done()
+		}(name)
+	}
+	wg.Wait()
+
+	n, nerr := int(cnt), int(errCnt)
+	if nerr == 0 {
+		actionDone(c, fmt.Sprintf("Updated custom props of %d object%s in %s", n, cos.Plural(n), bck.Cname("")))
+		return nil
+	}
+	return fmt.Errorf("failed to update %d object%s in %s (%d updated, %d not attempted)",
+		nerr, cos.Plural(nerr), bck.Cname(""), n, l-n-nerr)
+}
+
+// names from '--list' or '--template'; a template with no ranges is a prefix to list
+// (in which case returns the prefix and listed = true)
+func _setCustomNames(c *cli.Context, bck cmn.Bck) (_ []string, prefix string, listed bool, _ error) {
+	if flagIsSet(c, listFlag) {
+		if flagIsSet(c, templateFlag) {
+			return nil, "", false, fmt.Errorf(errFmtExclusive, qflprn(listFlag), qflprn(templateFlag))
+		}
+		names := splitCsv(parseStrFlag(c, listFlag))
+		if len(names) == 0 {
+			return nil, "", false, fmt.Errorf("empty %s", qflprn(listFlag))
+		}
+		return names, "", false, nil
+	}
+	pt, err := cos.NewParsedTemplate(parseStrFlag(c, templateFlag))
+	switch {
+	case err == nil && len(pt.Ranges) > 0:
+		return pt.ToSlice(), "", false, nil
+	case err == nil:
+		prefix = pt.Prefix
+	case err != cos.ErrEmptyTemplate:
+		return nil, "", false, err
+	}
+	msg := &apc.LsoMsg{Prefix: prefix}
+	msg.AddProps(apc.GetPropsName)
+	msg.SetFlag(apc.LsObjCached) // (custom props can only be set on objects that are present in the cluster)
+	objList, err := api.ListObjects(apiBP, bck, msg, api.ListArgs{})
+	if err != nil {
+		return nil, "", false, V(err)
+	}
+	names := make([]string, 0, len(objList.Entries))
+	for _, en := range objList.Entries {
+		names = append(names, en.Name)
+	}
+	return names, prefix, true, nil
+}
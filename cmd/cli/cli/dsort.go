@@ -285,6 +285,7 @@ func setupBucket(c *cli.Context, bck cmn.Bck) error {
 	}
 
 	cleanup := flagIsSet(c, cleanupFlag)
+	bpCache.del(bck)
 	if exists && cleanup {
 		if err := api.DestroyBucket(apiBP, bck); err != nil {
 			return V(err)
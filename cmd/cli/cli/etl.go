@@ -56,6 +56,7 @@ var (
 			copyPrependFlag,
 			copyDryRunFlag,
 			etlBucketRequestTimeout,
+			etlNumWorkersFlag,
 			listFlag,
 			templateFlag,
 			verbObjPrefixFlag,
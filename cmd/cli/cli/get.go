@@ -6,15 +6,20 @@
 package cli
 
 import (
+	"cmp"
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
@@ -63,9 +68,22 @@ func getHandler(c *cli.Context) error {
 		}
 	}
 
+	// multi-object: prefix, list, or template
+	multi := flagIsSet(c, getObjPrefixFlag) || flagIsSet(c, listFlag) || flagIsSet(c, templateFlag)
+	if flagIsSet(c, listFlag) && flagIsSet(c, templateFlag) {
+		return fmt.Errorf(errFmtExclusive, qflprn(listFlag), qflprn(templateFlag))
+	}
+	if flagIsSet(c, getObjPrefixFlag) && (flagIsSet(c, listFlag) || flagIsSet(c, templateFlag)) {
+		return fmt.Errorf("%s cannot be used together with %s or %s", qflprn(getObjPrefixFlag), qflprn(listFlag), qflprn(templateFlag))
+	}
+	if flagIsSet(c, getNumWorkersFlag) && !multi && !flagIsSet(c, blobDownloadFlag) {
+		return fmt.Errorf("option %s requires either %s or multiple objects (%s, %s, %s)", qflprn(getNumWorkersFlag),
+			qflprn(blobDownloadFlag), qflprn(getObjPrefixFlag), qflprn(listFlag), qflprn(templateFlag))
+	}
+
 	// source
 	uri := c.Args().Get(0)
-	bck, objName, err := parseBckObjURI(c, uri, flagIsSet(c, getObjPrefixFlag))
+	bck, objName, err := parseBckObjURI(c, uri, multi)
 	if err != nil {
 		return err
 	}
@@ -80,6 +98,71 @@ func getHandler(c *cli.Context) error {
 			qflprn(latestVerFlag), bck.String())
 	}
 
+	if flagIsSet(c, resumeFlag) {
+		// (resumable GET always downloads the entire object)
+		for _, fl := range []cli.Flag{offsetFlag, lengthFlag} {
+			if flagIsSet(c, fl) {
+				return fmt.Errorf(errFmtExclusive, qflprn(fl), qflprn(resumeFlag))
+			}
+		}
+		if flagIsSet(c, blobDownloadFlag) {
+			return fmt.Errorf(errFmtExclusive, qflprn(blobDownloadFlag), qflprn(resumeFlag))
+		}
+		if flagIsSet(c, archpathGetFlag) || flagIsSet(c, extractFlag) {
+			return fmt.Errorf("option %s cannot be used to read archived files", qflprn(resumeFlag))
+		}
+	}
+
+	if flagIsSet(c, getRetryCksumFlag) {
+		if n := parseIntFlag(c, getRetryCksumFlag); n < 0 {
+			return fmt.Errorf("invalid %s=%d: expecting non-negative number of retries", qflprn(getRetryCksumFlag), n)
+		}
+		if flagIsSet(c, resumeFlag) {
+			return fmt.Errorf(errFmtExclusive, qflprn(getRetryCksumFlag), qflprn(resumeFlag))
+		}
+		if c.NArg() > 1 && c.Args().Get(1) == fileStdIO {
+			return fmt.Errorf("option %s cannot be used to write to standard output", qflprn(getRetryCksumFlag))
+		}
+	}
+
+	if flagIsSet(c, headFirstFlag) && flagIsSet(c, headObjPresentFlag) {
+		return fmt.Errorf(errFmtExclusive, qflprn(headFirstFlag), qflprn(headObjPresentFlag))
+	}
+
+	if flagIsSet(c, decompressFlag) {
+		for _, fl := range []cli.Flag{offsetFlag, lengthFlag, resumeFlag, getRetryCksumFlag, checksumOnlyFlag, headObjPresentFlag} {
+			if flagIsSet(c, fl) {
+				return fmt.Errorf(errFmtExclusive, qflprn(decompressFlag), qflprn(fl))
+			}
+		}
+		if flagIsSet(c, archpathGetFlag) || flagIsSet(c, extractFlag) || flagIsSet(c, listArchFlag) {
+			return fmt.Errorf("option %s cannot be used to read archived files", qflprn(decompressFlag))
+		}
+	}
+
+	if flagIsSet(c, checksumOnlyFlag) {
+		if multi {
+			return fmt.Errorf("option %s cannot be used to get multiple objects (%s, %s, %s)", qflprn(checksumOnlyFlag),
+				qflprn(getObjPrefixFlag), qflprn(listFlag), qflprn(templateFlag))
+		}
+		for _, fl := range []cli.Flag{resumeFlag, blobDownloadFlag, headObjPresentFlag} {
+			if flagIsSet(c, fl) {
+				return fmt.Errorf(errFmtExclusive, qflprn(checksumOnlyFlag), qflprn(fl))
+			}
+		}
+		if flagIsSet(c, archpathGetFlag) || flagIsSet(c, extractFlag) {
+			return fmt.Errorf("option %s cannot be used to read archived files", qflprn(checksumOnlyFlag))
+		}
+		if c.NArg() > 1 {
+			return fmt.Errorf("option %s does not write to disk and does not take destination (have %q)",
+				qflprn(checksumOnlyFlag), c.Args().Get(1))
+		}
+	}
+	if flagIsSet(c, chunkValidateFlag) {
+		actionNote(c, "per-chunk checksums are not available - falling back to whole-object validation (same as "+
+			qflprn(cksumFlag)+")")
+	}
+
 	if flagIsSet(c, blobDownloadFlag) {
 		if flagIsSet(c, lengthFlag) {
 			return fmt.Errorf(errFmtExclusive, qflprn(lengthFlag), qflprn(blobDownloadFlag))
@@ -136,8 +219,9 @@ func getHandler(c *cli.Context) error {
 		}
 	}
 	if archpath != "" {
-		if flagIsSet(c, getObjPrefixFlag) {
-			return fmt.Errorf(errFmtExclusive, qflprn(getObjPrefixFlag), qflprn(archpathGetFlag))
+		if multi {
+			return fmt.Errorf("%s cannot be used to get multiple objects (%s, %s, %s)", qflprn(archpathGetFlag),
+				qflprn(getObjPrefixFlag), qflprn(listFlag), qflprn(templateFlag))
 		}
 		if flagIsSet(c, headObjPresentFlag) {
 			return fmt.Errorf("checking presence (%s) of archived files (%s) is not implemented yet",
@@ -149,13 +233,34 @@ func getHandler(c *cli.Context) error {
 		}
 	}
 
-	// GET multiple -- currently, only prefix (TODO: list/range)
-	if flagIsSet(c, getObjPrefixFlag) {
+	// destination directory that mirrors object names
+	if flagIsSet(c, getToDirFlag) {
+		if outFile != "" {
+			return fmt.Errorf("%s and destination %q cannot be used together", qflprn(getToDirFlag), outFile)
+		}
+		if archpath != "" {
+			return fmt.Errorf(errFmtExclusive, qflprn(getToDirFlag), qflprn(archpathGetFlag))
+		}
+		if flagIsSet(c, listArchFlag) {
+			return fmt.Errorf(errFmtExclusive, qflprn(getToDirFlag), qflprn(listArchFlag))
+		}
+	}
+
+	// archpath is a glob: list the shard, and GET all matching archived files
+	if flagIsSet(c, archGlobFlag) {
+		if archpath == "" {
+			return fmt.Errorf("%s requires %s", qflprn(archGlobFlag), qflprn(archpathGetFlag))
+		}
+		return getArchGlob(c, bck, objName, archpath, outFile)
+	}
+
+	// GET multiple
+	if multi {
 		if objName != "" {
-			if _, err := archive.Mime("", objName); err != nil {
+			if _, err := archive.Mime("", objName); err != nil || !flagIsSet(c, getObjPrefixFlag) {
 				// not an archive
-				return fmt.Errorf("object name in %q and %s cannot be used together (hint: use directory as destination)",
-					uri, qflprn(getObjPrefixFlag))
+				return fmt.Errorf("object name in %q cannot be used to get multiple objects (hint: use directory as destination)",
+					uri)
 			}
 		}
 		// calls `getObject` with progress bar and bells and whistles
@@ -163,16 +268,55 @@ func getHandler(c *cli.Context) error {
 	}
 
 	// GET
+	if flagIsSet(c, getToDirFlag) {
+		var err error
+		if outFile, err = toDirOut(c, objName); err != nil {
+			return err
+		}
+	}
 	return getObject(c, bck, objName, archpath, outFile, false /*quiet*/, extract)
 }
 
-// GET multiple -- currently, only prefix (TODO: list/range)
+// '--to-dir': <dir>/<object-name>, with object name's virtual directories created as needed
+func toDirOut(c *cli.Context, objName string) (string, error) {
+	dir := parseStrFlag(c, getToDirFlag)
+	outFile, err := joinUnder(dir, objName)
+	if err != nil {
+		return "", fmt.Errorf("object name %q cannot be written under %s %q (path traversal)", objName, qflprn(getToDirFlag), dir)
+	}
+	return outFile, cos.CreateDir(filepath.Dir(outFile))
+}
+
+// <dir>/<name>, provided the result stays under dir (no absolute names, no "..")
+func joinUnder(dir, name string) (string, error) {
+	if filepath.IsAbs(name) || slices.Contains(strings.Split(name, "/"), "..") {
+		return "", fmt.Errorf("%q: path traversal", name)
+	}
+	out := filepath.Join(dir, filepath.FromSlash(name))
+	if rel, err := filepath.Rel(dir, out); err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("%q: path traversal", name)
+	}
+	return out, nil
+}
+
+// GET multiple: by prefix, list, or template
 func getMultiObj(c *cli.Context, bck cmn.Bck, archpath, outFile string, extract bool) error {
 	var (
 		prefix     = parseStrFlag(c, getObjPrefixFlag)
 		origPrefix = prefix
 		lstFilter  = &lstFilter{}
+		names      []string
 	)
+	pageSize, limit, err := _setPage(c, bck)
+	if err != nil {
+		return err
+	}
+	if flagIsSet(c, listFlag) || flagIsSet(c, templateFlag) {
+		if names, prefix, err = _getLrNames(c, limit); err != nil {
+			return err
+		}
+		origPrefix = prefix
+	}
 	if flagIsSet(c, listArchFlag) && prefix != "" {
 		// when prefix crosses shard boundary
 		if external, internal := splitPrefixShardBoundary(prefix); internal != "" {
@@ -196,19 +340,23 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, archpath, outFile string, extract
 	if flagIsSet(c, useInventoryFlag) {
 		msg.SetFlag(apc.LsInventory)
 	}
-	pageSize, limit, err := _setPage(c, bck)
-	if err != nil {
-		return err
-	}
 	msg.PageSize = uint(pageSize)
 
-	// list-objects
-	objList, err := api.ListObjects(apiBP, bck, msg, api.ListArgs{Limit: uint(limit)})
-	if err != nil {
-		return V(err)
-	}
-	if lstFilter._len() > 0 {
-		objList.Entries, _ = lstFilter.apply(objList.Entries)
+	// list-objects or, when the names are explicitly listed (or generated), skip listing
+	var objList *cmn.LsoResult
+	if names != nil {
+		objList = &cmn.LsoResult{Entries: make(cmn.LsoEntries, 0, len(names))}
+		for _, name := range names {
+			objList.Entries = append(objList.Entries, &cmn.LsoEntry{Name: name})
+		}
+	} else {
+		objList, err = api.ListObjects(apiBP, bck, msg, api.ListArgs{Limit: uint(limit)})
+		if err != nil {
+			return V(err)
+		}
+		if lstFilter._len() > 0 {
+			objList.Entries, _ = lstFilter.apply(objList.Entries)
+		}
 	}
 
 	// can't do many to one
@@ -222,6 +370,14 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, archpath, outFile string, extract
 			}
 		}
 	}
+	// many to standard output: stream as a single archive
+	if outFile == fileStdIO && !flagIsSet(c, lengthFlag) && !extract && archpath == "" && !flagIsSet(c, listArchFlag) {
+		return getMultiArch(c, bck, objList.Entries, limit, names != nil /*sizes unknown*/)
+	}
+	if flagIsSet(c, getArchFormatFlag) {
+		return fmt.Errorf("option %s requires standard output (\"-\") as destination", qflprn(getArchFormatFlag))
+	}
+
 	// total size
 	var totalSize int64
 	for _, entry := range objList.Entries {
@@ -242,18 +398,27 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, archpath, outFile string, extract
 		discard = " (and discard)"
 	} else if outFile == fileStdIO {
 		out = " to standard output"
-	} else {
+	} else if flagIsSet(c, getToDirFlag) {
+		out = " to " + parseStrFlag(c, getToDirFlag)
+	} else if outFile != "" {
 		out = outFile
-		if out != "" && out[len(out)-1] == filepath.Separator {
+		if out[len(out)-1] == filepath.Separator {
 			out = out[:len(out)-1]
 		}
+		out = " to " + out
 	}
 	if flagIsSet(c, lengthFlag) {
 		verb = "Read range"
 	}
 
-	cptn := fmt.Sprintf("%s%s %d object%s from %s%s (total size %s)",
-		verb, discard, l, cos.Plural(l), bck.Cname(""), out, teb.FmtSize(totalSize, units, 2))
+	var cptn string
+	if names != nil {
+		// sizes unknown (not listed)
+		cptn = fmt.Sprintf("%s%s %d object%s from %s%s", verb, discard, l, cos.Plural(l), bck.Cname(""), out)
+	} else {
+		cptn = fmt.Sprintf("%s%s %d object%s from %s%s (total size %s)",
+			verb, discard, l, cos.Plural(l), bck.Cname(""), out, teb.FmtSize(totalSize, units, 2))
+	}
 
 	if flagIsSet(c, yesFlag) && (l > 1 || quiet) {
 		fmt.Fprintln(c.App.Writer, cptn)
@@ -261,9 +426,19 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, archpath, outFile string, extract
 		return nil
 	}
 	// context to get in parallel
+	numWorkers, err := _getNumWorkers(c)
+	if err != nil {
+		return err
+	}
 	u := &uctx{
 		showProgress: flagIsSet(c, progressFlag),
-		wg:           cos.NewLimitedWaitGroup(4, 0),
+		wg:           cos.NewLimitedWaitGroup(numWorkers, 0),
+		contOnErr:    flagIsSet(c, continueOnErrorFlag),
+	}
+	if !u.contOnErr {
+		// the first failure cancels all GETs in flight
+		u.initCancel()
+		defer u.cancel()
 	}
 	if u.showProgress {
 		var (
@@ -279,13 +454,30 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, archpath, outFile string, extract
 			}
 			totalBars []*mpb.Bar
 		)
-		u.progress, totalBars = simpleBar(filesBarArg, sizeBarArg)
+		if names != nil {
+			// total size unknown - objects only
+			u.progress, totalBars = simpleBar(filesBarArg)
+		} else {
+			u.progress, totalBars = simpleBar(filesBarArg, sizeBarArg)
+			u.barSize = totalBars[1]
+		}
 		u.barObjs = totalBars[0]
-		u.barSize = totalBars[1]
 	}
-	for _, entry := range objList.Entries {
+	var numSkipped, numIssued int
+	for i, entry := range objList.Entries {
 		var shardName string
 
+		// the first failure cancels the rest (unless '--cont-on-err')
+		if u.stopped() {
+			numSkipped = l - i
+			if u.showProgress {
+				for _, e := range objList.Entries[i:] {
+					u.incrBars(e.Size)
+				}
+			}
+			break
+		}
+
 		// NOTE: s3.ListObjectsV2 _may_ return a directory - filtering out
 		if err := cmn.ValidateObjName(entry.Name); err != nil {
 			warn := fmt.Sprintf("%v in the list-objects results (ignored)", err)
@@ -298,8 +490,7 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, archpath, outFile string, extract
 				if !strings.HasPrefix(entry.Name, origPrefix) {
 					// skip
 					if u.showProgress {
-						u.barObjs.IncrInt64(1)
-						u.barSize.IncrInt64(entry.Size)
+						u.incrBars(entry.Size)
 					}
 					continue
 				}
@@ -318,6 +509,7 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, archpath, outFile string, extract
 			}
 		}
 		u.wg.Add(1)
+		numIssued++
 		go u.get(c, bck, entry, shardName, outFile, quiet, extract)
 	}
 	u.wg.Wait()
@@ -326,12 +518,296 @@ func getMultiObj(c *cli.Context, bck cmn.Bck, archpath, outFile string, extract
 		u.progress.Wait()
 		fmt.Fprint(c.App.Writer, u.errSb.String())
 	}
-	if numFailed := u.errCount.Load(); numFailed > 0 {
-		return fmt.Errorf("failed to GET %d object%s", numFailed, cos.Plural(int(numFailed)))
+	numFailed := int(u.errCount.Load())
+	if limit > 0 {
+		n := numIssued - numFailed - int(u.canceled.Load())
+		actionDone(c, fmt.Sprintf("Fetched %d object%s from %s (%s=%d)", n, cos.Plural(n), bck.Cname(""), flprn(objLimitFlag), limit))
+	}
+	if numFailed == 0 {
+		return nil
+	}
+	numSkipped += int(u.canceled.Load())
+	cnt := l - numFailed - numSkipped
+	if numSkipped > 0 {
+		return fmt.Errorf("failed to GET %d object%s from %s (%d succeeded, %d canceled)",
+			numFailed, cos.Plural(numFailed), bck.Cname(""), cnt, numSkipped)
+	}
+	return fmt.Errorf("failed to GET %d object%s from %s (%d succeeded)", numFailed, cos.Plural(numFailed), bck.Cname(""), cnt)
+}
+
+// GET multiple objects and write them to standard output as a single (.tar, .tgz, or .zip) archive;
+// each object becomes an archived file named by its object name
+func getMultiArch(c *cli.Context, bck cmn.Bck, entries cmn.LsoEntries, limit int, unsized bool) error {
+	var mime string
+	switch f := parseStrFlag(c, getArchFormatFlag); f {
+	case "", "tar":
+		mime = archive.ExtTar
+	case "tgz", "tar.gz":
+		mime = archive.ExtTgz
+	case "zip":
+		mime = archive.ExtZip
+	default:
+		return fmt.Errorf("invalid %s %q: expecting one of: tar, tgz, zip", flprn(getArchFormatFlag), f)
+	}
+
+	// NOTE: s3.ListObjectsV2 _may_ return a directory - filtering out
+	var (
+		totalSize int64
+		objs      = make(cmn.LsoEntries, 0, len(entries))
+	)
+	for _, entry := range entries {
+		if limit > 0 && len(objs) >= limit {
+			break
+		}
+		if err := cmn.ValidateObjName(entry.Name); err != nil {
+			actionNote(c, fmt.Sprintf("%v in the list-objects results (ignored)", err))
+			continue
+		}
+		objs = append(objs, entry)
+		totalSize += entry.Size
+	}
+	l := len(objs)
+	if l == 0 {
+		return fmt.Errorf("no objects to GET from %s", bck.Cname(""))
+	}
+
+	// confirm; standard output is the archive - prompting via standard error
+	cptn := fmt.Sprintf("GET %d object%s from %s to standard output as a single %s", l, cos.Plural(l), bck.Cname(""), mime)
+	if !unsized {
+		cptn += " (total size " + cos.ToSizeIEC(totalSize, 2) + ")"
+	}
+	if !flagIsSet(c, yesFlag) {
+		w := c.App.Writer
+		c.App.Writer = c.App.ErrWriter
+		ok := confirm(c, cptn)
+		c.App.Writer = w
+		if !ok {
+			return nil
+		}
+	}
+
+	var (
+		aw       = archive.NewWriter(mime, os.Stdout, nil /*cksum*/, nil /*opts*/)
+		contErr  = flagIsSet(c, continueOnErrorFlag)
+		now      = time.Now().UnixNano()
+		progress *mpb.Progress
+		bars     []*mpb.Bar
+		nfailed  int
+	)
+	if flagIsSet(c, progressFlag) {
+		progress, bars = simpleBarTo(c.App.ErrWriter, barArgs{total: int64(l), barText: "Objects:    ", barType: unitsArg})
+	}
+	err := _getMultiArch(c, aw, bck, objs, now, contErr, bars, &nfailed)
+	aw.Fini()
+	if progress != nil {
+		if err != nil {
+			bars[0].Abort(true)
+		}
+		progress.Wait()
+	}
+	switch {
+	case err != nil:
+		return err
+	case nfailed > 0:
+		return fmt.Errorf("failed to GET %d object%s from %s (%d succeeded)", nfailed, cos.Plural(nfailed), bck.Cname(""), l-nfailed)
+	case limit > 0:
+		n := l - nfailed
+		fmt.Fprintf(c.App.ErrWriter, "Fetched %d object%s from %s (%s=%d)\n", n, cos.Plural(n), bck.Cname(""), flprn(objLimitFlag), limit)
+	}
+	return nil
+}
+
+func _getMultiArch(c *cli.Context, aw archive.Writer, bck cmn.Bck, objs cmn.LsoEntries, now int64, contErr bool,
+	bars []*mpb.Bar, nfailed *int) error {
+	for _, entry := range objs {
+		r, size, err := api.GetObjectReader(apiBP, bck, entry.Name, &api.GetArgs{})
+		if err == nil {
+			oah := &cmn.ObjAttrs{Size: size, Atime: now}
+			err = aw.Write(entry.Name, oah, r)
+			r.Close()
+		}
+		if bars != nil {
+			bars[0].Increment()
+		}
+		if err != nil {
+			if !contErr {
+				return fmt.Errorf("%s: %v", bck.Cname(entry.Name), err)
+			}
+			actionWarn(c, bck.Cname(entry.Name)+": "+err.Error())
+			*nfailed++
+		}
 	}
 	return nil
 }
 
+// GET archived files that match glob (and/or brace-expanded) archpath, e.g.:
+// 'ais get ais://nnn/shard.tar --archpath "data/*.{json,txt}" --glob /tmp/out'
+func getArchGlob(c *cli.Context, bck cmn.Bck, shardName, archpath, outFile string) error {
+	if shardName == "" {
+		return fmt.Errorf("glob %s requires shard name", qflprn(archpathGetFlag))
+	}
+	patterns := expandBraces(archpath)
+	for _, ptrn := range patterns {
+		if _, err := path.Match(ptrn, ""); err != nil {
+			return fmt.Errorf("invalid %s %q: %v", qflprn(archpathGetFlag), archpath, err)
+		}
+	}
+
+	// list archived content
+	msg := &apc.LsoMsg{Prefix: shardName}
+	msg.AddProps(apc.GetPropsMinimal...)
+	msg.SetFlag(apc.LsArchDir)
+	objList, err := api.ListObjects(apiBP, bck, msg, api.ListArgs{})
+	if err != nil {
+		return V(err)
+	}
+	var matches cmn.LsoEntries
+	for _, entry := range objList.Entries {
+		if !entry.IsInsideArch() || !strings.HasPrefix(entry.Name, shardName+"/") {
+			continue
+		}
+		fname := strings.TrimPrefix(entry.Name, shardName+"/")
+		for _, ptrn := range patterns {
+			if ok, _ := path.Match(ptrn, fname); ok {
+				matches = append(matches, entry)
+				break
+			}
+		}
+	}
+
+	l := len(matches)
+	switch {
+	case l == 0:
+		return &errDoesNotExist{what: "archived file", name: bck.Cname(shardName) + "/" + archpath}
+	case l == 1:
+		fname := strings.TrimPrefix(matches[0].Name, shardName+"/")
+		return getObject(c, bck, shardName, fname, outFile, false /*quiet*/, false /*extract*/)
+	}
+
+	// many: destination must be a directory
+	outDir := outFile
+	switch {
+	case outDir == "":
+		outDir = "."
+	case outDir == fileStdIO:
+		return fmt.Errorf("cannot write %d archived files (matching %q) to standard output", l, archpath)
+	case discardOutput(outDir):
+	default:
+		finfo, errEx := os.Stat(outDir)
+		if errEx != nil || !finfo.IsDir() {
+			return fmt.Errorf("cannot write %d archived files (matching %q) to a single file %q (hint: use directory as destination)",
+				l, archpath, outDir)
+		}
+	}
+	var (
+		numFailed int
+		contOnErr = flagIsSet(c, continueOnErrorFlag)
+		quiet     = !flagIsSet(c, verboseFlag)
+	)
+	for _, entry := range matches {
+		var (
+			fname = strings.TrimPrefix(entry.Name, shardName+"/")
+			out   = outDir
+		)
+		if !discardOutput(outDir) {
+			// retain archived directory structure (but never write outside destination directory)
+			var err error
+			if out, err = joinUnder(outDir, fname); err != nil {
+				err = fmt.Errorf("archived file %s cannot be written under %q: %v", fname, outDir, err)
+				if !contOnErr {
+					return err
+				}
+				actionWarn(c, err.Error())
+				numFailed++
+				continue
+			}
+			if err := cos.CreateDir(filepath.Dir(out)); err != nil {
+				return err
+			}
+		}
+		if err := getObject(c, bck, shardName, fname, out, quiet, false /*extract*/); err != nil {
+			if !contOnErr {
+				return err
+			}
+			actionWarn(c, err.Error())
+			numFailed++
+		}
+	}
+	if numFailed > 0 {
+		return fmt.Errorf("failed to GET %d (out of %d) archived file%s from %s", numFailed, l, cos.Plural(l), bck.Cname(shardName))
+	}
+	if quiet {
+		actionDone(c, fmt.Sprintf("GET %d archived files (matching %q) from %s\n", l, archpath, bck.Cname(shardName)))
+	}
+	return nil
+}
+
+// expand comma-separated alternatives, e.g. "a/{b,c}/*.{json,txt}" => 4 patterns
+func expandBraces(ptrn string) []string {
+	i := strings.IndexByte(ptrn, '{')
+	if i < 0 {
+		return []string{ptrn}
+	}
+	j := strings.IndexByte(ptrn[i:], '}')
+	if j < 0 {
+		return []string{ptrn}
+	}
+	j += i
+	var (
+		out  []string
+		alts = strings.Split(ptrn[i+1:j], ",")
+		tail = expandBraces(ptrn[j+1:])
+	)
+	for _, alt := range alts {
+		for _, t := range tail {
+			out = append(out, ptrn[:i]+alt+t)
+		}
+	}
+	return out
+}
+
+// GET multiple: '--list' or '--template'
+// (returns generated names or, when the template is a "pure" prefix, the prefix)
+// - non-zero limit: return (at most) the first `limit` names
+func _getLrNames(c *cli.Context, limit int) (names []string, prefix string, err error) {
+	if flagIsSet(c, listFlag) {
+		names = splitCsv(parseStrFlag(c, listFlag))
+		if len(names) == 0 {
+			err = fmt.Errorf("empty %s", qflprn(listFlag))
+		}
+		if limit > 0 && len(names) > limit {
+			names = names[:limit]
+		}
+		return names, "", err
+	}
+	tmpl := parseStrFlag(c, templateFlag)
+	pt, err := cos.NewParsedTemplate(tmpl)
+	if err != nil {
+		if err == cos.ErrEmptyTemplate {
+			return nil, "", nil // same as '--prefix ""' (entire bucket)
+		}
+		return nil, "", err
+	}
+	if len(pt.Ranges) == 0 {
+		return nil, pt.Prefix, nil
+	}
+	if limit > 0 {
+		return pt.ToSlice(limit), "", nil
+	}
+	return pt.ToSlice(), "", nil
+}
+
+func _getNumWorkers(c *cli.Context) (int, error) {
+	if !flagIsSet(c, getNumWorkersFlag) || flagIsSet(c, blobDownloadFlag) {
+		return dfltGetMultiWorkers, nil
+	}
+	nw := parseIntFlag(c, getNumWorkersFlag)
+	if nw <= 0 || nw > maxGetMultiWorkers {
+		return 0, fmt.Errorf("invalid %s=%d: expecting (1..%d) range", flprn(getNumWorkersFlag), nw, maxGetMultiWorkers)
+	}
+	return nw, nil
+}
+
 //////////
 // uctx - "get" extension
 //////////
@@ -341,6 +817,14 @@ func (u *uctx) get(c *cli.Context, bck cmn.Bck, entry *cmn.LsoEntry, shardName,
 		objName  = entry.Name
 		archpath string
 	)
+	if u.stopped() {
+		u.canceled.Inc()
+		if u.showProgress {
+			u.incrBars(entry.Size)
+		}
+		u.wg.Done()
+		return
+	}
 	if shardName != "" {
 		objName = shardName
 		archpath = strings.TrimPrefix(entry.Name, shardName+"/")
@@ -352,14 +836,39 @@ func (u *uctx) get(c *cli.Context, bck cmn.Bck, entry *cmn.LsoEntry, shardName,
 				actionWarn(c, err.Error())
 			}
 		}
+	} else if flagIsSet(c, getToDirFlag) {
+		var err error
+		if outFile, err = toDirOut(c, objName); err != nil {
+			u.errCount.Inc()
+			if u.showProgress {
+				u.incrBars(entry.Size)
+				u.errSb.WriteString(err.Error() + "\n")
+			} else {
+				actionWarn(c, err.Error())
+			}
+			u.wg.Done()
+			return
+		}
+	}
+	bp := apiBP
+	if u.bp != nil {
+		bp = *u.bp
 	}
-	err := getObject(c, bck, objName, archpath, outFile, quiet, extract)
+	err := _getObject(c, bp, bck, objName, archpath, outFile, quiet, extract)
 	if err != nil {
-		u.errCount.Inc()
+		if u.ctx != nil && u.ctx.Err() != nil {
+			// canceled by a prior failure (partial output removed - see _getObject)
+			u.canceled.Inc()
+			err = nil
+		} else {
+			u.errCount.Inc()
+			if u.cancel != nil {
+				u.cancel()
+			}
+		}
 	}
 	if u.showProgress {
-		u.barObjs.IncrInt64(1)
-		u.barSize.IncrInt64(entry.Size)
+		u.incrBars(entry.Size)
 		if err != nil {
 			u.errSb.WriteString(err.Error() + "\n")
 		}
@@ -370,8 +879,48 @@ func (u *uctx) get(c *cli.Context, bck cmn.Bck, entry *cmn.LsoEntry, shardName,
 	u.wg.Done()
 }
 
+func (u *uctx) stopped() bool { return !u.contOnErr && u.errCount.Load() > 0 }
+
+// API params with a client that cancels all its requests (including reading response bodies)
+// upon u.cancel()
+func (u *uctx) initCancel() {
+	u.ctx, u.cancel = context.WithCancel(context.Background())
+	var (
+		bp     = apiBP
+		client = *apiBP.Client
+		rt     = client.Transport
+	)
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	client.Transport = &ctxTransport{ctx: u.ctx, rt: rt}
+	bp.Client = &client
+	u.bp = &bp
+}
+
+type ctxTransport struct {
+	ctx context.Context
+	rt  http.RoundTripper
+}
+
+func (t *ctxTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.rt.RoundTrip(req.WithContext(t.ctx))
+}
+
+func (u *uctx) incrBars(size int64) {
+	u.barObjs.IncrInt64(1)
+	if u.barSize != nil {
+		u.barSize.IncrInt64(size)
+	}
+}
+
 // get one (main function)
-func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, quiet, extract bool) (err error) {
+func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, quiet, extract bool) error {
+	return _getObject(c, apiBP, bck, objName, archpath, outFile, quiet, extract)
+}
+
+// (bp: API params that may carry a cancelable client - see uctx.initCancel)
+func _getObject(c *cli.Context, bp api.BaseParams, bck cmn.Bck, objName, archpath, outFile string, quiet, extract bool) (err error) {
 	var (
 		getArgs api.GetArgs
 		oah     api.ObjAttrs
@@ -399,12 +948,25 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, q
 		return err
 	}
 
-	var offset, length int64
-	if offset, err = parseSizeFlag(c, offsetFlag, units); err != nil {
-		return err
-	}
-	if length, err = parseSizeFlag(c, lengthFlag, units); err != nil {
-		return err
+	var (
+		offset, length int64
+		rngs           []htrange
+	)
+	if strings.ContainsRune(parseStrFlag(c, offsetFlag), ',') || strings.ContainsRune(parseStrFlag(c, lengthFlag), ',') {
+		if rngs, err = parseRanges(c, units); err != nil {
+			return err
+		}
+		if flagIsSet(c, checksumOnlyFlag) {
+			return fmt.Errorf("option %s cannot be used with multiple ranges (%s, %s)", qflprn(checksumOnlyFlag),
+				qflprn(offsetFlag), qflprn(lengthFlag))
+		}
+	} else {
+		if offset, err = parseSizeFlag(c, offsetFlag, units); err != nil {
+			return err
+		}
+		if length, err = parseSizeFlag(c, lengthFlag, units); err != nil {
+			return err
+		}
 	}
 
 	// where to
@@ -439,6 +1001,10 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, q
 		}
 	}
 
+	if rngs != nil {
+		return getMultiRange(c, bck, objName, outFile, rngs, units, quiet)
+	}
+
 	var hdr http.Header
 	if length > 0 {
 		rng := cmn.MakeRangeHdr(offset, length)
@@ -453,35 +1019,79 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, q
 			}
 			hdr.Set(apc.HdrBlobChunk, parseStrFlag(c, chunkSizeFlag))
 		}
-		if flagIsSet(c, numWorkersFlag) {
-			nw := parseIntFlag(c, numWorkersFlag)
+		if flagIsSet(c, getNumWorkersFlag) {
+			nw := parseIntFlag(c, getNumWorkersFlag)
 			if nw <= 0 || nw > 128 {
-				return fmt.Errorf("invalid %s=%d: expecting (1..128) range", flprn(numWorkersFlag), nw)
+				return fmt.Errorf("invalid %s=%d: expecting (1..128) range", flprn(getNumWorkersFlag), nw)
 			}
 			hdr.Set(apc.HdrBlobWorkers, strconv.Itoa(nw))
 		}
-	} else if flagIsSet(c, chunkSizeFlag) || flagIsSet(c, numWorkersFlag) {
-		return fmt.Errorf("command line options (%s, %s) can be used only together with %s",
-			qflprn(chunkSizeFlag), qflprn(numWorkersFlag), qflprn(blobDownloadFlag))
+	} else if flagIsSet(c, chunkSizeFlag) {
+		return fmt.Errorf("command line option %s can be used only together with %s",
+			qflprn(chunkSizeFlag), qflprn(blobDownloadFlag))
+	}
+
+	// validate checksum only (and discard)
+	if flagIsSet(c, checksumOnlyFlag) {
+		return getCksumOnly(c, bck, objName, hdr, units, offset)
 	}
 
+	// fail fast, before creating destination (note: HEAD(object) is an extra round trip)
+	if flagIsSet(c, headFirstFlag) {
+		if _, err := api.HeadObject(bp, bck, objName, apc.FltExistsNoProps, true /*silent*/); err != nil {
+			if cmn.IsStatusNotFound(err) {
+				return &errDoesNotExist{what: "object", name: bck.Cname(objName)}
+			}
+			return V(err)
+		}
+	}
+
+	var (
+		rsm   *resumable
+		ofile *os.File
+	)
 	if outFile == fileStdIO {
 		getArgs = api.GetArgs{Writer: os.Stdout, Header: hdr}
 		quiet = true
 	} else if discardOutput(outFile) {
 		getArgs = api.GetArgs{Writer: io.Discard, Header: hdr}
-	} else {
-		var file *os.File
-		if file, err = os.Create(outFile); err != nil {
+	} else if flagIsSet(c, resumeFlag) {
+		rsm = &resumable{outFile: outFile, part: outFile + partSuffix}
+		var (
+			file *os.File
+			done bool
+		)
+		if file, done, err = rsm.open(c, bck, objName); err != nil {
 			return err
 		}
+		if done {
+			if err = rsm.fini(); err == nil && !quiet {
+				fmt.Fprintf(c.App.Writer, "GET %s: already downloaded as %s\n", bck.Cname(objName), outFile)
+			}
+			return err
+		}
+		if rsm.offset > 0 {
+			rng := cmn.MakeRangeHdr(rsm.offset, rsm.size-rsm.offset)
+			hdr = http.Header{cos.HdrRange: []string{rng}}
+		}
 		defer func() {
 			file.Close()
+			if err == nil {
+				err = rsm.fini()
+			}
+		}()
+		getArgs = api.GetArgs{Writer: file, Header: hdr}
+	} else {
+		if ofile, err = os.Create(outFile); err != nil {
+			return err
+		}
+		defer func() {
+			ofile.Close()
 			if err != nil {
 				os.Remove(outFile)
 			}
 		}()
-		getArgs = api.GetArgs{Writer: file, Header: hdr}
+		getArgs = api.GetArgs{Writer: ofile, Header: hdr}
 	}
 
 	// finally, http query
@@ -489,11 +1099,41 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, q
 		getArgs.Query = _getQparams(c, &bck, archpath)
 	}
 
+	var dcmp *decompressor
+	if flagIsSet(c, decompressFlag) {
+		dcmp = newDecompressor(getArgs.Writer)
+		getArgs.Writer = dcmp
+	}
+
 	// do
-	if flagIsSet(c, cksumFlag) {
-		oah, err = api.GetObjectWithValidation(apiBP, bck, objName, &getArgs)
-	} else {
-		oah, err = api.GetObject(apiBP, bck, objName, &getArgs)
+	retries := parseIntFlag(c, getRetryCksumFlag)
+	for i := 0; ; i++ {
+		if flagIsSet(c, cksumFlag) || flagIsSet(c, chunkValidateFlag) || retries > 0 {
+			oah, err = api.GetObjectWithValidation(bp, bck, objName, &getArgs)
+		} else {
+			oah, err = api.GetObject(bp, bck, objName, &getArgs)
+		}
+		var errCksum *cmn.ErrInvalidCksum
+		if err == nil || i >= retries || !errors.As(err, &errCksum) {
+			break
+		}
+		actionWarn(c, fmt.Sprintf("GET %s: %v - retrying (%d/%d)", bck.Cname(objName), err, i+1, retries))
+		// remove the bad one and start over
+		if ofile != nil {
+			ofile.Close()
+			os.Remove(outFile)
+			if ofile, err = os.Create(outFile); err != nil {
+				return err
+			}
+			getArgs.Writer = ofile
+		}
+	}
+	var dsize int64
+	if dcmp != nil {
+		var errD error
+		if dsize, errD = dcmp.fini(err); err == nil && errD != nil {
+			err = fmt.Errorf("failed to decompress %s: %v", bck.Cname(objName), errD)
+		}
 	}
 	if err != nil {
 		if cmn.IsStatusNotFound(err) && archpath == "" {
@@ -506,6 +1146,15 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, q
 		mime   string
 		objLen = oah.Size()
 	)
+	if rsm != nil {
+		if err = rsm.check(&oah); err != nil {
+			return err
+		}
+		objLen = rsm.size
+	}
+	if dcmp != nil {
+		objLen = dsize
+	}
 	if extract {
 		mime, err = doExtract(objName, outFile, objLen)
 		if err != nil {
@@ -559,6 +1208,236 @@ func getObject(c *cli.Context, bck cmn.Bck, objName, archpath, outFile string, q
 	return
 }
 
+//
+// GET multiple ranges: comma-separated '--offset' and '--length'
+//
+
+type htrange struct {
+	offset, length int64
+}
+
+func parseRanges(c *cli.Context, units string) ([]htrange, error) {
+	var (
+		offs = strings.Split(parseStrFlag(c, offsetFlag), ",")
+		lens = strings.Split(parseStrFlag(c, lengthFlag), ",")
+	)
+	if len(offs) != len(lens) {
+		return nil, fmt.Errorf("number of offsets (%d) must match number of lengths (%d)", len(offs), len(lens))
+	}
+	rngs := make([]htrange, len(offs))
+	for i := range offs {
+		var err error
+		if rngs[i].offset, err = cos.ParseSize(strings.TrimSpace(offs[i]), units); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %v", qflprn(offsetFlag), offs[i], err)
+		}
+		if rngs[i].length, err = cos.ParseSize(strings.TrimSpace(lens[i]), units); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %v", qflprn(lengthFlag), lens[i], err)
+		}
+		if rngs[i].offset < 0 || rngs[i].length <= 0 {
+			return nil, fmt.Errorf("invalid range #%d: offset %d, length %d", i+1, rngs[i].offset, rngs[i].length)
+		}
+	}
+	return rngs, nil
+}
+
+// validate ranges against object size and each other (must not overlap)
+func checkRanges(rngs []htrange, size int64) error {
+	sorted := slices.Clone(rngs)
+	slices.SortFunc(sorted, func(a, b htrange) int { return cmp.Compare(a.offset, b.offset) })
+	for i, r := range sorted {
+		if r.offset+r.length > size {
+			return fmt.Errorf("range [%d, %d) is out of bounds (object size %d)", r.offset, r.offset+r.length, size)
+		}
+		if i > 0 && sorted[i-1].offset+sorted[i-1].length > r.offset {
+			return fmt.Errorf("ranges [%d, %d) and [%d, %d) overlap", sorted[i-1].offset,
+				sorted[i-1].offset+sorted[i-1].length, r.offset, r.offset+r.length)
+		}
+	}
+	return nil
+}
+
+// one range read per range; the ranges are written back to back, in the specified order
+// (multipart/byteranges is not supported by the cluster)
+func getMultiRange(c *cli.Context, bck cmn.Bck, objName, outFile string, rngs []htrange, units string, quiet bool) (err error) {
+	props, err := api.HeadObject(apiBP, bck, objName, apc.FltExists, true /*silent*/)
+	if err != nil {
+		if cmn.IsStatusNotFound(err) {
+			err = &errDoesNotExist{what: "object", name: bck.Cname(objName)}
+		}
+		return err
+	}
+	if err := checkRanges(rngs, props.Size); err != nil {
+		return fmt.Errorf("%s: %v", bck.Cname(objName), err)
+	}
+
+	var w io.Writer
+	switch {
+	case outFile == fileStdIO:
+		w, quiet = os.Stdout, true
+	case discardOutput(outFile):
+		w = io.Discard
+	default:
+		var file *os.File
+		if file, err = os.Create(outFile); err != nil {
+			return err
+		}
+		defer func() {
+			file.Close()
+			if err != nil {
+				os.Remove(outFile)
+			}
+		}()
+		w = file
+	}
+
+	var total int64
+	for _, r := range rngs {
+		getArgs := api.GetArgs{
+			Writer: w,
+			Header: http.Header{cos.HdrRange: []string{cmn.MakeRangeHdr(r.offset, r.length)}},
+		}
+		if _, err = api.GetObject(apiBP, bck, objName, &getArgs); err != nil {
+			return err
+		}
+		total += r.length
+	}
+	if !quiet {
+		var out string
+		if discardOutput(outFile) {
+			out = " (and discard)"
+		} else {
+			out = " as " + outFile
+		}
+		fmt.Fprintf(c.App.Writer, "Read %d ranges (total length %s) from %s%s\n", len(rngs),
+			teb.FmtSize(total, units, 2), bck.Cname(objName), out)
+	}
+	return nil
+}
+
+//
+// GET and validate checksum (without writing to disk)
+//
+
+type cntWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *cntWriter) Write(b []byte) (int, error) {
+	n, err := cw.w.Write(b)
+	cw.n += int64(n)
+	return n, err
+}
+
+func getCksumOnly(c *cli.Context, bck cmn.Bck, objName string, hdr http.Header, units string, offset int64) error {
+	var (
+		cw      = &cntWriter{w: io.Discard}
+		getArgs = api.GetArgs{Writer: cw, Header: hdr}
+		what    = bck.Cname(objName)
+	)
+	if bck.IsHTTP() || flagIsSet(c, silentFlag) || flagIsSet(c, latestVerFlag) {
+		getArgs.Query = _getQparams(c, &bck, "")
+	}
+	if flagIsSet(c, lengthFlag) {
+		what = fmt.Sprintf("%s range (length %s at offset %d)", what, parseStrFlag(c, lengthFlag), offset)
+	}
+	oah, err := api.GetObjectWithValidation(apiBP, bck, objName, &getArgs)
+	if err != nil {
+		if cmn.IsStatusNotFound(err) {
+			return &errDoesNotExist{what: "object", name: bck.Cname(objName)}
+		}
+		var errCksum *cmn.ErrInvalidCksum
+		if errors.As(err, &errCksum) {
+			return fmt.Errorf("%s: checksum validation FAILED: %v", what, err)
+		}
+		return err
+	}
+	cksum := oah.Attrs().Cksum
+	fmt.Fprintf(c.App.Writer, "%s: %s[%s] OK (validated %s)\n", what, cksum.Type(), cksum.Value(), teb.FmtSize(cw.n, units, 2))
+	return nil
+}
+
+//
+// resumable GET
+//
+
+const (
+	partSuffix = ".part"     // partially downloaded content
+	verSuffix  = ".part.ver" // object version the partial content belongs to
+)
+
+type resumable struct {
+	outFile string
+	part    string
+	ver     string // (remote) object version at the time of HEAD
+	offset  int64  // resume from
+	size    int64  // full object size
+}
+
+// HEAD the object and open (or create) <outFile>.part; resume iff the version and size check out,
+// otherwise restart from zero
+func (rsm *resumable) open(c *cli.Context, bck cmn.Bck, objName string) (file *os.File, done bool, err error) {
+	props, err := api.HeadObject(apiBP, bck, objName, apc.FltExists, true /*silent*/)
+	if err != nil {
+		if cmn.IsStatusNotFound(err) {
+			err = &errDoesNotExist{what: "object", name: bck.Cname(objName)}
+		}
+		return nil, false, err
+	}
+	rsm.ver, rsm.size = props.Ver, props.Size
+
+	finfo, errS := os.Stat(rsm.part)
+	if errS == nil {
+		var prevVer string
+		if b, errR := os.ReadFile(rsm.outFile + verSuffix); errR == nil {
+			prevVer = string(b)
+		}
+		switch {
+		case prevVer != rsm.ver:
+			warn := fmt.Sprintf("%s: version changed (%q vs %q) - restarting from zero", bck.Cname(objName), prevVer, rsm.ver)
+			actionWarn(c, warn)
+		case finfo.Size() > rsm.size:
+			warn := fmt.Sprintf("%s: size changed (%d vs partial %d) - restarting from zero", bck.Cname(objName),
+				rsm.size, finfo.Size())
+			actionWarn(c, warn)
+		case finfo.Size() == rsm.size:
+			return nil, true, nil
+		default:
+			rsm.offset = finfo.Size()
+			file, err = os.OpenFile(rsm.part, os.O_APPEND|os.O_WRONLY, cos.PermRWR)
+			return file, false, err
+		}
+	}
+	if err = os.WriteFile(rsm.outFile+verSuffix, []byte(rsm.ver), cos.PermRWR); err != nil {
+		return nil, false, err
+	}
+	file, err = os.Create(rsm.part)
+	return file, false, err
+}
+
+// compare (version, size) returned by GET with those we have started with
+func (rsm *resumable) check(oah *api.ObjAttrs) error {
+	attrs := oah.Attrs()
+	if attrs.Ver != "" && attrs.Ver != rsm.ver {
+		os.Remove(rsm.part)
+		return fmt.Errorf("object version changed during download (%q vs %q) - removed %q, please retry",
+			rsm.ver, attrs.Ver, rsm.part)
+	}
+	if n := oah.Size(); rsm.offset+n != rsm.size {
+		return fmt.Errorf("failed to resume download: expected %d bytes at offset %d, got %d (partial content in %q)",
+			rsm.size-rsm.offset, rsm.offset, n, rsm.part)
+	}
+	return nil
+}
+
+func (rsm *resumable) fini() error {
+	if err := os.Rename(rsm.part, rsm.outFile); err != nil {
+		return err
+	}
+	os.Remove(rsm.outFile + verSuffix)
+	return nil
+}
+
 func _getQparams(c *cli.Context, bck *cmn.Bck, archpath string) (q url.Values) {
 	q = make(url.Values, 2)
 	if bck.IsHTTP() {
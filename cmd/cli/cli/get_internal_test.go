@@ -0,0 +1,96 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestExpandBraces(t *testing.T) {
+	tests := []struct {
+		ptrn     string
+		expected []string
+	}{
+		{"a/b.txt", []string{"a/b.txt"}},
+		{"*.{json,txt}", []string{"*.json", "*.txt"}},
+		{"a/{b,c}/*.{json,txt}", []string{"a/b/*.json", "a/b/*.txt", "a/c/*.json", "a/c/*.txt"}},
+		{"{a}", []string{"a"}},
+		{"x{,y}", []string{"x", "xy"}},
+		{"a{b", []string{"a{b"}},   // (unbalanced)
+		{"a}b{", []string{"a}b{"}}, // ditto
+	}
+	for _, test := range tests {
+		out := expandBraces(test.ptrn)
+		tassert.Errorf(t, reflect.DeepEqual(out, test.expected), "%q: expected %v, got %v", test.ptrn, test.expected, out)
+	}
+}
+
+func TestJoinUnder(t *testing.T) {
+	const dir = "/tmp/out"
+	tests := []struct {
+		name string
+		out  string // empty when expecting error
+	}{
+		{"a.txt", "/tmp/out/a.txt"},
+		{"a/b/c.txt", "/tmp/out/a/b/c.txt"},
+		{"a/./b", "/tmp/out/a/b"},
+		{"../a", ""},
+		{"a/../../b", ""},
+		{"/etc/passwd", ""},
+		{".", ""},
+	}
+	for _, test := range tests {
+		out, err := joinUnder(dir, test.name)
+		if test.out == "" {
+			tassert.Errorf(t, err != nil, "%q: expected error, got %q", test.name, out)
+			continue
+		}
+		tassert.Errorf(t, err == nil && out == filepath.FromSlash(test.out), "%q: expected %q, got %q (%v)",
+			test.name, test.out, out, err)
+	}
+}
+
+// the first failure cancels multi-object GETs in flight, including those reading response body
+func TestUctxCancel(t *testing.T) {
+	stop := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte("partial"))
+		w.(http.Flusher).Flush()
+		<-stop
+	}))
+	defer func() {
+		close(stop)
+		srv.Close()
+	}()
+	apiBP.Client = &http.Client{}
+
+	u := &uctx{}
+	u.initCancel()
+	resp, err := u.bp.Client.Get(srv.URL)
+	tassert.CheckFatal(t, err)
+	defer resp.Body.Close()
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := io.ReadAll(resp.Body)
+		errCh <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+	u.cancel()
+	select {
+	case err := <-errCh:
+		tassert.Errorf(t, err != nil, "expected canceled read to fail")
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for canceled read")
+	}
+}
@@ -0,0 +1,79 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// 'ais get --decompress': GET writes (compressed) bytes into a pipe, while the other side
+// detects the format by content, decompresses, and writes the result to the destination
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+type decompressor struct {
+	pw   *io.PipeWriter
+	done chan struct{}
+	err  error
+	n    int64 // decompressed size
+}
+
+// interface guard
+var _ io.Writer = (*decompressor)(nil)
+
+func newDecompressor(w io.Writer) *decompressor {
+	pr, pw := io.Pipe()
+	d := &decompressor{pw: pw, done: make(chan struct{})}
+	go d.run(pr, w)
+	return d
+}
+
+func (d *decompressor) Write(b []byte) (int, error) { return d.pw.Write(b) }
+
+// call upon GET completion (or failure) to wait for the decompressed output
+func (d *decompressor) fini(errGet error) (int64, error) {
+	d.pw.CloseWithError(errGet)
+	<-d.done
+	return d.n, d.err
+}
+
+func (d *decompressor) run(pr *io.PipeReader, w io.Writer) {
+	var (
+		r          io.Reader
+		br         = bufio.NewReader(pr)
+		magic, err = br.Peek(len(zstdMagic))
+	)
+	switch {
+	case err != nil && err != io.EOF:
+	case bytes.HasPrefix(magic, gzipMagic):
+		var gzr *gzip.Reader
+		if gzr, err = gzip.NewReader(br); err == nil {
+			r = gzr
+		}
+	case bytes.Equal(magic, zstdMagic):
+		var zr *zstd.Decoder
+		if zr, err = zstd.NewReader(br); err == nil {
+			defer zr.Close()
+			r = zr
+		}
+	default:
+		err = errors.New("unrecognized compression format (expecting gzip or zstd)")
+	}
+	if err == nil {
+		d.n, err = io.Copy(w, r)
+	}
+	d.err = err
+	pr.CloseWithError(err) // (when failed: unblock the writer)
+	close(d.done)
+}
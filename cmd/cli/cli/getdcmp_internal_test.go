@@ -0,0 +1,73 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/klauspost/compress/zstd"
+)
+
+func TestDecompressor(t *testing.T) {
+	data := make([]byte, 256*1024)
+	_, err := rand.Read(data)
+	tassert.CheckFatal(t, err)
+
+	var gz bytes.Buffer
+	gzw := gzip.NewWriter(&gz)
+	_, err = gzw.Write(data)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, gzw.Close())
+
+	var zs bytes.Buffer
+	zw, err := zstd.NewWriter(&zs)
+	tassert.CheckFatal(t, err)
+	_, err = zw.Write(data)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, zw.Close())
+
+	for name, compressed := range map[string][]byte{"gzip": gz.Bytes(), "zstd": zs.Bytes()} {
+		t.Run(name, func(t *testing.T) {
+			var out bytes.Buffer
+			d := newDecompressor(&out)
+			// (GET writes in chunks)
+			for b := compressed; len(b) > 0; {
+				n := min(len(b), 1000)
+				_, err := d.Write(b[:n])
+				tassert.CheckFatal(t, err)
+				b = b[n:]
+			}
+			size, err := d.fini(nil)
+			tassert.CheckFatal(t, err)
+			tassert.Errorf(t, size == int64(len(data)), "expected size %d, got %d", len(data), size)
+			tassert.Errorf(t, bytes.Equal(out.Bytes(), data), "round trip: content differs")
+		})
+	}
+
+	t.Run("unrecognized", func(t *testing.T) {
+		var out bytes.Buffer
+		d := newDecompressor(&out)
+		d.Write([]byte("plain text, not compressed")) //nolint:errcheck // (fails once the format is detected)
+		_, err := d.fini(nil)
+		tassert.Errorf(t, err != nil, "expected unrecognized format to fail")
+	})
+
+	t.Run("failed-get", func(t *testing.T) {
+		var (
+			out    bytes.Buffer
+			errGet = errors.New("connection reset")
+		)
+		d := newDecompressor(&out)
+		_, err := d.Write(gz.Bytes()[:100])
+		tassert.CheckFatal(t, err)
+		_, err = d.fini(errGet)
+		tassert.Errorf(t, err != nil, "expected truncated stream to fail")
+	})
+}
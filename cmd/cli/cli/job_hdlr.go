@@ -15,6 +15,7 @@ import (
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ext/dload"
@@ -48,6 +49,7 @@ var (
 		jobStartSub,
 		jobStopSub,
 		jobWaitSub,
+		jobSetMaxBWSub,
 		jobRemoveSub,
 		makeAlias(showCmdJob, "", true, commandShow), // alias for `ais show`
 	}
@@ -84,6 +86,7 @@ var (
 			verbObjPrefixFlag, // to disambiguate bucket/prefix vs bucket/objName
 			latestVerFlag,
 			blobThresholdFlag,
+			continueOnErrorFlag,
 		),
 		cmdBlobDownload: {
 			refreshFlag,
@@ -215,6 +218,20 @@ var (
 	}
 )
 
+// ais job set-max-bw
+var (
+	jobSetMaxBWSub = cli.Command{
+		Name: commandSetMaxBW,
+		Usage: "change or remove bandwidth limit (bytes per second, per target) of a running bucket-to-bucket copy, e.g.:\n" +
+			indent1 + "\t- 'set-max-bw cysbohAGL 200MiB'\t- limit a given job to 200MiB/s per target;\n" +
+			indent1 + "\t- 'set-max-bw cysbohAGL 0'\t- remove the limit\n" +
+			indent1 + "\t(see also: 'ais cp --max-bw')",
+		ArgsUsage:    jobMaxBWArgument,
+		Action:       setMaxBWHandler,
+		BashComplete: runningJobCompletions,
+	}
+)
+
 // ais job remove
 var (
 	removeCmdsFlags = []cli.Flag{
@@ -1298,3 +1315,31 @@ func xid2Name(xid string) (name, otherID string) {
 	}
 	return
 }
+
+func setMaxBWHandler(c *cli.Context) error {
+	switch c.NArg() {
+	case 0:
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	case 1:
+		return missingArgumentsError(c, "BANDWIDTH")
+	case 2:
+	default:
+		return incorrectUsageMsg(c, "too many arguments %v", c.Args()[2:])
+	}
+	var (
+		xid, s     = c.Args().Get(0), c.Args().Get(1)
+		maxBW, err = cos.ParseSize(s, cos.UnitsIEC)
+	)
+	if err != nil || maxBW < 0 {
+		return fmt.Errorf("invalid bandwidth %q: expecting non-negative size %s, or 0 to remove the limit", s, sizeUnitsIEC)
+	}
+	if err := api.SetXactMaxBW(apiBP, xid, maxBW); err != nil {
+		return V(err)
+	}
+	if maxBW == 0 {
+		actionDone(c, "Removed bandwidth limit of the job "+xid)
+	} else {
+		actionDone(c, fmt.Sprintf("Limited bandwidth of the job %s to %s/s (per target)", xid, cos.ToSizeIEC(maxBW, 0)))
+	}
+	return nil
+}
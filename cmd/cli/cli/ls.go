@@ -243,6 +243,9 @@ func listBckTableWithSummary(c *cli.Context, qbck cmn.QueryBcks, bcks cmn.Bcks,
 }
 
 func listObjects(c *cli.Context, bck cmn.Bck, prefix string, listArch bool) error {
+	if flagIsSet(c, lsS3Flag) {
+		return listObjectsS3(c, bck, prefix)
+	}
 	// prefix and filter
 	lstFilter, prefixFromTemplate, err := newLstFilter(c)
 	if err != nil {
@@ -0,0 +1,149 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/urfave/cli"
+)
+
+// 'ais ls BUCKET --s3': list objects via the cluster's S3 API (ListObjectsV2) and show the response as is -
+// to compare with the native listing (common prefixes, continuation tokens, key encoding)
+// (implemented over Go text/tabwriter directly w/ no templates)
+
+type (
+	// (a subset of ListObjectsV2 response - see ais/s3/types.go)
+	lsS3Result struct {
+		Name                  string       `xml:"Name"`
+		Prefix                string       `xml:"Prefix"`
+		Delimiter             string       `xml:"Delimiter"`
+		StartAfter            string       `xml:"StartAfter"`
+		EncodingType          string       `xml:"EncodingType"`
+		KeyCount              int          `xml:"KeyCount"`
+		MaxKeys               int          `xml:"MaxKeys"`
+		IsTruncated           bool         `xml:"IsTruncated"`
+		ContinuationToken     string       `xml:"ContinuationToken"`
+		NextContinuationToken string       `xml:"NextContinuationToken"`
+		Contents              []lsS3Entry  `xml:"Contents"`
+		CommonPrefixes        []lsS3Prefix `xml:"CommonPrefixes"`
+	}
+	lsS3Entry struct {
+		Key          string `xml:"Key"`
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag"`
+		Class        string `xml:"StorageClass"`
+		Size         int64  `xml:"Size"`
+	}
+	lsS3Prefix struct {
+		Prefix string `xml:"Prefix"`
+	}
+	lsS3ErrResult struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+)
+
+func listObjectsS3(c *cli.Context, bck cmn.Bck, prefix string) error {
+	if !bck.IsAIS() && !bck.IsCloud() {
+		return fmt.Errorf("option %s requires ais:// or cloud bucket (have %s)", qflprn(lsS3Flag), bck.Cname(""))
+	}
+	if !bck.Ns.IsGlobal() {
+		return fmt.Errorf("option %s: S3 API does not support namespaces (have %s)", qflprn(lsS3Flag), bck.Cname(""))
+	}
+	q := url.Values{}
+	q.Set("list-type", "2")
+	if prefix != "" {
+		q.Set("prefix", prefix)
+	}
+	if flagIsSet(c, lsS3DelimiterFlag) {
+		q.Set("delimiter", parseStrFlag(c, lsS3DelimiterFlag))
+	}
+	if flagIsSet(c, startAfterFlag) {
+		q.Set("start-after", parseStrFlag(c, startAfterFlag))
+	}
+	if flagIsSet(c, pageSizeFlag) {
+		q.Set("max-keys", strconv.Itoa(parseIntFlag(c, pageSizeFlag)))
+	}
+	if flagIsSet(c, lsS3URLEncodingFlag) {
+		q.Set("encoding-type", "url")
+	}
+
+	var (
+		tw       = &tabwriter.Writer{}
+		maxPages = parseIntFlag(c, maxPagesFlag)
+	)
+	tw.Init(c.App.Writer, 0, 8, 2, ' ', 0)
+	for pageNum := 1; ; pageNum++ {
+		res, err := lsS3Page(bck, q)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(tw, "PAGE %d\tKeyCount: %d\tMaxKeys: %d\tIsTruncated: %t\tContinuationToken: %q\tNextContinuationToken: %q\n",
+			pageNum, res.KeyCount, res.MaxKeys, res.IsTruncated, res.ContinuationToken, res.NextContinuationToken)
+		if res.EncodingType != "" {
+			fmt.Fprintf(tw, "EncodingType: %s\n", res.EncodingType)
+		}
+		if !flagIsSet(c, noHeaderFlag) && len(res.Contents) > 0 {
+			fmt.Fprintln(tw, "KEY\tSIZE\tETAG\tCLASS\tLAST-MODIFIED")
+		}
+		for _, e := range res.Contents {
+			fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%s\n", e.Key, e.Size, e.ETag, e.Class, e.LastModified)
+		}
+		for _, cp := range res.CommonPrefixes {
+			fmt.Fprintf(tw, "%s\t(common prefix)\n", cp.Prefix)
+		}
+		tw.Flush()
+
+		if !res.IsTruncated || res.NextContinuationToken == "" || (maxPages > 0 && pageNum >= maxPages) {
+			return nil
+		}
+		q.Set("continuation-token", res.NextContinuationToken)
+		q.Del("start-after")
+	}
+}
+
+func lsS3Page(bck cmn.Bck, q url.Values) (*lsS3Result, error) {
+	path := apc.URLPathS3.Join(bck.Name)
+	req, err := http.NewRequest(http.MethodGet, apiBP.URL+path+"?"+q.Encode(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	if apiBP.Token != "" {
+		req.Header.Set(apc.HdrAuthorization, apc.AuthenticationTypeBearer+" "+apiBP.Token)
+	}
+	req.Header.Set(cos.HdrUserAgent, apiBP.UA)
+	resp, err := apiBP.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		var e lsS3ErrResult
+		if xml.Unmarshal(body, &e) == nil && e.Code != "" {
+			return nil, fmt.Errorf("S3 ListObjectsV2 %s: %s (%d): %s", bck.Cname(""), e.Code, resp.StatusCode, e.Message)
+		}
+		return nil, fmt.Errorf("S3 ListObjectsV2 %s: %s", bck.Cname(""), resp.Status)
+	}
+	res := &lsS3Result{}
+	if err := xml.Unmarshal(body, res); err != nil {
+		return nil, errors.New("failed to parse S3 ListObjectsV2 response: " + err.Error())
+	}
+	return res, nil
+}
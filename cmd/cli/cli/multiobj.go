@@ -17,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 )
@@ -26,6 +27,7 @@ const dryRunExamplesCnt = 10
 type lrCtx struct {
 	listObjs, tmplObjs string
 	bck                cmn.Bck
+	names              []string // already listed (and filtered) object names - see `rmOlderThan`
 }
 
 // x-TCO: multi-object transform or copy
@@ -56,11 +58,19 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 		actionWarn(c, "cannot show progress bar with an empty list/range type option - not implemented yet")
 		showProgress = false
 	}
+	batchSize := parseIntFlag(c, copyBatchSizeFlag)
+	if batchSize < 0 || (batchSize > 0 && numObjs == 0) {
+		return fmt.Errorf("invalid %s=%d: expecting a positive number with %s or %s (that has ranges)",
+			qflprn(copyBatchSizeFlag), batchSize, qflprn(listFlag), qflprn(templateFlag))
+	}
 
 	// 2. TCO message
 	msg := cmn.TCObjsMsg{ToBck: bckTo}
 	{
 		msg.ListRange = lrMsg
+		if err := parseCopyNaming(c, &msg.CopyBckMsg); err != nil {
+			return err
+		}
 		msg.DryRun = flagIsSet(c, copyDryRunFlag)
 		if flagIsSet(c, etlBucketRequestTimeout) {
 			msg.Timeout = cos.Duration(etlBucketRequestTimeout.Value)
@@ -68,6 +78,11 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 		msg.LatestVer = flagIsSet(c, latestVerFlag)
 		msg.Sync = flagIsSet(c, syncFlag)
 		msg.ContinueOnError = flagIsSet(c, continueOnErrorFlag)
+		msg.NumWorkers = parseIntFlag(c, copyNumWorkersFlag)
+		if msg.NumWorkers < 0 || msg.NumWorkers > apc.MaxTCONumWorkers {
+			return fmt.Errorf("invalid %s=%d: expecting [0, %d] range", qflprn(copyNumWorkersFlag), msg.NumWorkers,
+				apc.MaxTCONumWorkers)
+		}
 	}
 	// 3. start copying/transforming
 	var (
@@ -80,11 +95,14 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 		msg.Name = etlName
 		text = "Transforming objects"
 		xkind = apc.ActETLObjects
-		xid, err = api.ETLMultiObj(apiBP, bckFrom, &msg)
 	} else {
 		xkind = apc.ActCopyObjects
-		xid, err = api.CopyMultiObj(apiBP, bckFrom, &msg)
 	}
+	if batchSize > 0 && numObjs > int64(batchSize) {
+		tb := tcoBatches{bckFrom: bckFrom, bckTo: bckTo, xkind: xkind, text: text, numObjs: numObjs}
+		return tb.run(c, &msg, batchSize, showProgress)
+	}
+	xid, err = startTCO(bckFrom, &msg, xkind)
 	if err != nil {
 		return err
 	}
@@ -121,7 +139,7 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
 	}
 	xargs := xact.ArgsMsg{ID: xid, Kind: xkind, Timeout: timeout}
-	if err = waitXact(&xargs); err != nil {
+	if err = waitCopyXact(c, &xargs); err != nil {
 		fmt.Fprintf(c.App.ErrWriter, fmtXactFailed, text, bckFrom, bckTo)
 	} else {
 		fmt.Fprint(c.App.Writer, fmtXactSucceeded)
@@ -129,6 +147,133 @@ func runTCO(c *cli.Context, bckFrom, bckTo cmn.Bck, listObjs, tmplObjs, etlName
 	return err
 }
 
+func startTCO(bckFrom cmn.Bck, msg *cmn.TCObjsMsg, xkind string) (string, error) {
+	if xkind == apc.ActETLObjects {
+		return api.ETLMultiObj(apiBP, bckFrom, msg)
+	}
+	return api.CopyMultiObj(apiBP, bckFrom, msg)
+}
+
+//
+// '--batch-size': split the list (or template-generated names) into batches and start
+// one x-tco per batch; progress and the final count are aggregated across all of them
+//
+
+type tcoBatches struct {
+	bckFrom, bckTo cmn.Bck
+	xkind          string
+	text           string
+	xids           []string
+	numObjs        int64
+}
+
+func (tb *tcoBatches) run(c *cli.Context, msg *cmn.TCObjsMsg, batchSize int, showProgress bool) error {
+	names := msg.ObjNames
+	if len(names) == 0 {
+		pt, err := cos.NewParsedTemplate(msg.Template)
+		if err != nil {
+			return err
+		}
+		names = make([]string, 0, tb.numObjs)
+		pt.InitIter()
+		for name, hasNext := pt.Next(); hasNext; name, hasNext = pt.Next() {
+			names = append(names, name)
+		}
+	}
+	tb.xids = make([]string, 0, len(names)/batchSize+1)
+	for i := 0; i < len(names); i += batchSize {
+		bmsg := *msg
+		bmsg.ListRange = apc.ListRange{ObjNames: names[i:min(i+batchSize, len(names))]}
+		xid, err := startTCO(tb.bckFrom, &bmsg, tb.xkind)
+		if err != nil {
+			if len(tb.xids) > 0 {
+				actionWarn(c, fmt.Sprintf("started %d job%s (%s) prior to failing to start the next one",
+					len(tb.xids), cos.Plural(len(tb.xids)), strings.Join(tb.xids, ", ")))
+			}
+			return V(err)
+		}
+		if !cos.StringInSlice(xid, tb.xids) { // (in case the cluster reuses the running job)
+			tb.xids = append(tb.xids, xid)
+		}
+	}
+
+	// progress bar
+	_, xname := xact.GetKindName(tb.xkind)
+	if showProgress {
+		var cpr = cprCtx{
+			xids:  tb.xids,
+			kind:  tb.xkind,
+			bck:   tb.bckFrom,
+			xname: xname,
+			from:  tb.bckFrom.Cname(""),
+			to:    tb.bckTo.Cname(""),
+		}
+		cpr.totals.objs = tb.numObjs
+		cpr.loghdr = fmt.Sprintf("%s[%d jobs] %s => %s", xname, len(tb.xids), cpr.from, cpr.to)
+		return cpr.multiobj(c, tb.text)
+	}
+
+	// done
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		if flagIsSet(c, nonverboseFlag) {
+			fmt.Fprintln(c.App.Writer, strings.Join(tb.xids, "\n"))
+		} else {
+			actionDone(c, fmt.Sprintf("%s (%d job%s: %s). %s", tcbtcoCptn(tb.text, tb.bckFrom, tb.bckTo),
+				len(tb.xids), cos.Plural(len(tb.xids)), strings.Join(tb.xids, ", "), toMonitorMsg(c, "", "")))
+		}
+		return nil
+	}
+
+	// or wait (all jobs, within the same overall timeout)
+	var (
+		timeout time.Duration
+		started = mono.NanoTime()
+	)
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	fmt.Fprintf(c.App.Writer, tcbtcoCptn(tb.text, tb.bckFrom, tb.bckTo)+" (%d jobs) ...", len(tb.xids))
+	for _, xid := range tb.xids {
+		var err error
+		xargs := xact.ArgsMsg{ID: xid, Kind: tb.xkind, Timeout: timeout}
+		if timeout > 0 {
+			xargs.Timeout = timeout - mono.Since(started)
+		}
+		if timeout > 0 && xargs.Timeout <= 0 {
+			err = copyTimedOut(c, xid, tb.xkind, timeout)
+		} else {
+			err = waitCopyXact(c, &xargs)
+		}
+		if err != nil {
+			fmt.Fprintf(c.App.ErrWriter, fmtXactFailed, tb.text, tb.bckFrom, tb.bckTo)
+			return err
+		}
+	}
+	fmt.Fprint(c.App.Writer, fmtXactSucceeded)
+	return tb.done(c)
+}
+
+// combined final count
+func (tb *tcoBatches) done(c *cli.Context) error {
+	xs, err := queryXactions(&xact.ArgsMsg{Kind: tb.xkind, Bck: tb.bckFrom})
+	if err != nil {
+		return V(err)
+	}
+	var objs, size int64
+	for _, xid := range tb.xids {
+		n, _, _ := xs.ObjCounts(xid)
+		b, _, _ := xs.ByteCounts(xid)
+		objs += n
+		size += b
+	}
+	actionDone(c, tcoBatchesCptn(objs, size, len(tb.xids)))
+	return nil
+}
+
+func tcoBatchesCptn(objs, size int64, njobs int) string {
+	return fmt.Sprintf("Total: %d object%s (%s) in %d jobs", objs, cos.Plural(int(objs)), cos.ToSizeIEC(size, 2), njobs)
+}
+
 //
 // evict, rm, prefetch ------------------------------------------------------------------------
 //
@@ -143,12 +288,7 @@ func evictHandler(c *cli.Context) error {
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
-	for shift := range c.Args() {
-		if err := _evictOne(c, shift); err != nil {
-			return err
-		}
-	}
-	return nil
+	return multiObjOp(c, _evictOne)
 }
 
 // handle one BUCKET[/OBJECT_NAME_or_TEMPLATE] (command line may contain multiple of those)
@@ -169,14 +309,24 @@ func _evictOne(c *cli.Context, shift int) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, keepMDFlag) && (objName != "" || listObjs != "" || tmplObjs != "") {
+		// object metadata is stored alongside (and together with) object data
+		return fmt.Errorf("option %s applies to the entire bucket only (and keeps bucket metadata)\n"+
+			"(Tip: evicted objects of remote buckets remain listed - as not present in-cluster - see 'ais ls %s --help')",
+			qflprn(keepMDFlag), bck.Cname(""))
+	}
 
 	switch {
 	case listObjs != "" || tmplObjs != "": // 1. multi-obj
-		lrCtx := &lrCtx{listObjs, tmplObjs, bck}
+		lrCtx := &lrCtx{listObjs: listObjs, tmplObjs: tmplObjs, bck: bck}
 		return lrCtx.do(c)
 	case objName == "": // 2. entire bucket
 		return evictBucket(c, bck)
 	default: // 3. one(?) obj to evict
+		if flagIsSet(c, dryRunFlag) {
+			fmt.Fprintf(c.App.Writer, "%s %s\n", strings.ToUpper(c.Command.Name), bck.Cname(objName))
+			return nil
+		}
 		err := api.EvictObject(apiBP, bck, objName)
 		if err == nil {
 			if !flagIsSet(c, nonverboseFlag) {
@@ -201,15 +351,13 @@ func rmHandler(c *cli.Context) error {
 	if flagIsSet(c, verboseFlag) && flagIsSet(c, nonverboseFlag) {
 		return incorrectUsageMsg(c, errFmtExclusive, qflprn(verboseFlag), qflprn(nonverboseFlag))
 	}
+	if flagIsSet(c, dryRunFlag) {
+		dryRunCptn(c)
+	}
 	if c.NArg() == 0 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
 	}
-	for shift := range c.Args() {
-		if err := _rmOne(c, shift); err != nil {
-			return err
-		}
-	}
-	return nil
+	return multiObjOp(c, _rmOne)
 }
 
 // handle one BUCKET[/OBJECT_NAME_or_TEMPLATE] (command line may contain multiple of those)
@@ -226,13 +374,34 @@ func _rmOne(c *cli.Context, shift int) error {
 	if err != nil {
 		return err
 	}
+	if flagIsSet(c, rmOlderThanFlag) {
+		if listObjs != "" {
+			return fmt.Errorf(errFmtExclusive, qflprn(rmOlderThanFlag), qflprn(listFlag))
+		}
+		return rmOlderThan(c, bck, cos.Either(tmplObjs, objName))
+	}
 
 	switch {
-	case listObjs != "" || tmplObjs != "": // 1. multi-obj
-		lrCtx := &lrCtx{listObjs, tmplObjs, bck}
+	case listObjs != "" || tmplObjs != "": // 1. multi-obj (server-side, via list-range xaction)
+		lrCtx := &lrCtx{listObjs: listObjs, tmplObjs: tmplObjs, bck: bck}
 		return lrCtx.do(c)
-	case objName == "": // 2. all objects
+	case objName == "" && flagIsSet(c, verbObjPrefixFlag) && !flagIsSet(c, rmrfFlag):
+		// 2. explicitly empty '--prefix': all objects (server-side), with confirmation
+		if !flagIsSet(c, yesFlag) && !flagIsSet(c, dryRunFlag) {
+			warn := fmt.Sprintf("empty %s: will remove all objects from %s. The operation cannot be undone!",
+				qflprn(verbObjPrefixFlag), bck.Cname(""))
+			if ok := confirm(c, "Proceed?", warn); !ok {
+				return nil
+			}
+		}
+		lrCtx := &lrCtx{bck: bck}
+		return lrCtx.do(c)
+	case objName == "": // 3. all objects
 		if flagIsSet(c, rmrfFlag) {
+			if flagIsSet(c, dryRunFlag) {
+				fmt.Fprintf(c.App.Writer, "%s all objects from %s\n", strings.ToUpper(c.Command.Name), bck.Cname(""))
+				return nil
+			}
 			if !flagIsSet(c, yesFlag) {
 				warn := fmt.Sprintf("will remove all objects from %s. The operation cannot be undone!", bck)
 				if ok := confirm(c, "Proceed?", warn); !ok {
@@ -241,9 +410,13 @@ func _rmOne(c *cli.Context, shift int) error {
 			}
 			return rmRfAllObjects(c, bck)
 		}
-		return incorrectUsageMsg(c, "use one of: (%s or %s or %s) to indicate _which_ objects to remove",
-			qflprn(listFlag), qflprn(templateFlag), qflprn(rmrfFlag))
-	default: // 3. one obj
+		return incorrectUsageMsg(c, "use one of: (%s or %s or %s or %s) to indicate _which_ objects to remove",
+			qflprn(listFlag), qflprn(templateFlag), qflprn(verbObjPrefixFlag), qflprn(rmrfFlag))
+	default: // 4. one obj
+		if flagIsSet(c, dryRunFlag) {
+			fmt.Fprintf(c.App.Writer, "%s %s\n", strings.ToUpper(c.Command.Name), bck.Cname(objName))
+			return nil
+		}
 		err := api.DeleteObject(apiBP, bck, objName)
 		if err == nil {
 			if !flagIsSet(c, nonverboseFlag) {
@@ -264,6 +437,34 @@ func _rmOne(c *cli.Context, shift int) error {
 	}
 }
 
+// run `fn` for each BUCKET[/OBJECT_NAME_or_TEMPLATE] on the command line;
+// stop at the first error unless '--cont-on-err' - in which case keep going
+// and summarize all failures at the end
+func multiObjOp(c *cli.Context, fn func(*cli.Context, int) error) error {
+	if !flagIsSet(c, continueOnErrorFlag) {
+		for shift := range c.Args() {
+			if err := fn(c, shift); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var errs []error
+	for shift := range c.Args() {
+		if err := fn(c, shift); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", c.Args().Get(shift), err))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	n, nfailed := c.NArg(), len(errs)
+	for _, err := range errs {
+		actionWarn(c, err.Error())
+	}
+	return fmt.Errorf("%s: %d of %d failed (%d succeeded)", c.Command.Name, nfailed, n, n-nfailed)
+}
+
 func startPrefetchHandler(c *cli.Context) error {
 	if flagIsSet(c, dryRunFlag) {
 		dryRunCptn(c)
@@ -271,12 +472,7 @@ func startPrefetchHandler(c *cli.Context) error {
 	if c.NArg() == 0 {
 		return incorrectUsageMsg(c, c.Command.ArgsUsage)
 	}
-	for shift := range c.Args() {
-		if err := _prefetchOne(c, shift); err != nil {
-			return err
-		}
-	}
-	return nil
+	return multiObjOp(c, _prefetchOne)
 }
 
 // ditto
@@ -300,7 +496,58 @@ func _prefetchOne(c *cli.Context, shift int) error {
 	if listObjs == "" && tmplObjs == "" {
 		listObjs = objName
 	}
-	lrCtx := &lrCtx{listObjs, tmplObjs, bck}
+	lrCtx := &lrCtx{listObjs: listObjs, tmplObjs: tmplObjs, bck: bck}
+	return lrCtx.do(c)
+}
+
+// 'rm --older-than': list (prefix-matching) objects with their access times
+// and delete those that are older than the specified duration or timestamp
+// (filtering is done on the client side)
+func rmOlderThan(c *cli.Context, bck cmn.Bck, prefix string) error {
+	if isPattern(prefix) {
+		return fmt.Errorf("option %s requires prefix (cannot be used with range template %q)", qflprn(rmOlderThanFlag), prefix)
+	}
+	var (
+		cutoff time.Time
+		val    = parseStrFlag(c, rmOlderThanFlag)
+	)
+	if d, err := time.ParseDuration(val); err == nil {
+		cutoff = time.Now().Add(-d)
+	} else if cutoff, err = time.Parse(time.RFC3339, val); err != nil {
+		return fmt.Errorf("invalid %s %q: expecting duration (e.g., 720h) or RFC3339 timestamp (e.g., 2024-01-02T15:04:05Z)",
+			qflprn(rmOlderThanFlag), val)
+	}
+
+	msg := &apc.LsoMsg{Prefix: prefix, TimeFormat: time.RFC3339Nano}
+	msg.AddProps(apc.GetPropsAtime)
+	lst, err := api.ListObjects(apiBP, bck, msg, api.ListArgs{})
+	if err != nil {
+		return V(err)
+	}
+	names := make([]string, 0, len(lst.Entries))
+	for _, en := range lst.Entries {
+		atime, err := time.Parse(time.RFC3339Nano, en.Atime)
+		if err != nil || en.Flags&apc.EntryIsDir != 0 {
+			continue // (e.g., remote object that is not present in the cluster)
+		}
+		if atime.Before(cutoff) {
+			names = append(names, en.Name)
+		}
+	}
+	n, l := len(names), len(lst.Entries)
+	if n == 0 {
+		fmt.Fprintf(c.App.Writer, "No objects in %s older than %s (listed %d)\n", bck.Cname(prefix), val, l)
+		return nil
+	}
+	if !flagIsSet(c, nonverboseFlag) {
+		fmt.Fprintf(c.App.Writer, "%d out of %d listed object%s older than %s\n", n, l, cos.Plural(l), val)
+	}
+	if !flagIsSet(c, yesFlag) && !flagIsSet(c, dryRunFlag) {
+		if ok := confirm(c, fmt.Sprintf("Remove %d object%s from %s?", n, cos.Plural(n), bck.Cname(""))); !ok {
+			return nil
+		}
+	}
+	lrCtx := &lrCtx{bck: bck, names: names}
 	return lrCtx.do(c)
 }
 
@@ -316,7 +563,9 @@ func (lr *lrCtx) do(c *cli.Context) (err error) {
 		emptyTemplate bool
 	)
 	// 1. parse
-	if lr.listObjs != "" {
+	if lr.names != nil {
+		fileList = lr.names
+	} else if lr.listObjs != "" {
 		fileList = splitCsv(lr.listObjs)
 	} else {
 		pt, err = cos.NewParsedTemplate(lr.tmplObjs) // NOTE: prefix w/ no range is fine
@@ -335,18 +584,27 @@ func (lr *lrCtx) do(c *cli.Context) (err error) {
 		return
 	}
 
+	// progress bar total: prefix (with no range) or entire bucket requires listing
+	// (prior to starting the job)
+	var (
+		showProgress = flagIsSet(c, progressFlag)
+		num          int64
+	)
+	if showProgress && fileList == nil && len(pt.Ranges) == 0 {
+		if num, err = lr.count(c, pt.Prefix); err != nil {
+			return err
+		}
+	}
+
 	// 3. do
-	xid, kind, action, errV := lr._do(c, fileList)
+	xid, kind, action, err := lr._do(c, fileList)
 	if err != nil {
-		return V(errV)
+		return V(err)
 	}
 
 	// 4. format
-	var (
-		xname, text string
-		num         int64
-	)
-	if lr.listObjs != "" {
+	var xname, text string
+	if fileList != nil {
 		num = int64(len(fileList))
 		s := fmt.Sprintf("%v", fileList)
 		if num > 4 {
@@ -355,7 +613,9 @@ func (lr *lrCtx) do(c *cli.Context) (err error) {
 		_, xname = xact.GetKindName(kind)
 		text = fmt.Sprintf("%s: %s %s from %s", xact.Cname(xname, xid), s, action, lr.bck.Cname(""))
 	} else {
-		num = pt.Count()
+		if len(pt.Ranges) > 0 {
+			num = pt.Count()
+		}
 		_, xname = xact.GetKindName(kind)
 		if emptyTemplate {
 			text = fmt.Sprintf("%s: %s entire bucket %s", xact.Cname(xname, xid), action, lr.bck.Cname(""))
@@ -365,7 +625,6 @@ func (lr *lrCtx) do(c *cli.Context) (err error) {
 	}
 
 	// 5. progress
-	showProgress := flagIsSet(c, progressFlag)
 	if showProgress {
 		var cpr = cprCtx{
 			xname:  xname,
@@ -379,6 +638,10 @@ func (lr *lrCtx) do(c *cli.Context) (err error) {
 
 	// 6. otherwise, wait or exit
 	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		if flagIsSet(c, nonverboseFlag) {
+			fmt.Fprintln(c.App.Writer, xid)
+			return nil
+		}
 		if xid != "" {
 			text += ". " + toMonitorMsg(c, xid, "")
 		}
@@ -400,6 +663,27 @@ func (lr *lrCtx) do(c *cli.Context) (err error) {
 	return nil
 }
 
+// number of objects to process when there's no list and no range
+// (evict: only those that are present in the cluster)
+func (lr *lrCtx) count(c *cli.Context, prefix string) (int64, error) {
+	msg := &apc.LsoMsg{Prefix: prefix}
+	msg.SetFlag(apc.LsNameOnly)
+	if c.Command.Name == commandEvict || (isAlias(c) && lastAliasedWord(c) == commandEvict) {
+		msg.SetFlag(apc.LsObjCached)
+	}
+	lst, err := api.ListObjects(apiBP, lr.bck, msg, api.ListArgs{})
+	if err != nil {
+		return 0, V(err)
+	}
+	var n int64
+	for _, en := range lst.Entries {
+		if en.Flags&apc.EntryIsDir == 0 {
+			n++
+		}
+	}
+	return n, nil
+}
+
 // [DRY-RUN]
 func (lr *lrCtx) dry(c *cli.Context, fileList []string, pt *cos.ParsedTemplate) {
 	if len(fileList) > 0 {
@@ -407,6 +691,15 @@ func (lr *lrCtx) dry(c *cli.Context, fileList []string, pt *cos.ParsedTemplate)
 			dryRunExamplesCnt, strings.ToUpper(c.Command.Name)+" "+lr.bck.Cname("")+"/%s\n", fileList)
 		return
 	}
+	if len(pt.Ranges) == 0 { // prefix (or entire bucket) - to be listed server-side
+		if pt.Prefix == "" {
+			fmt.Fprintf(c.App.Writer, "%s all objects from %s\n", strings.ToUpper(c.Command.Name), lr.bck.Cname(""))
+		} else {
+			fmt.Fprintf(c.App.Writer, "%s all objects with prefix %q from %s\n", strings.ToUpper(c.Command.Name),
+				pt.Prefix, lr.bck.Cname(""))
+		}
+		return
+	}
 	objs := pt.ToSlice(dryRunExamplesCnt)
 	limitedLineWriter(c.App.Writer,
 		dryRunExamplesCnt, strings.ToUpper(c.Command.Name)+" "+lr.bck.Cname("")+"/%s", objs)
@@ -6,11 +6,17 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/signal"
 	"path/filepath"
 	"sort"
 	"strings"
 	ratomic "sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -20,6 +26,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/sys"
+	"github.com/NVIDIA/aistore/xact"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/urfave/cli"
 	"github.com/vbauerster/mpb/v4"
@@ -39,6 +46,7 @@ func promote(c *cli.Context, bck cmn.Bck, objName, fqn string) error {
 		SrcIsNotFshare: flagIsSet(c, notFshareFlag),
 		OverwriteDst:   flagIsSet(c, overwriteFlag),
 		DeleteSrc:      flagIsSet(c, deleteSrcFlag),
+		ComputeCksum:   flagIsSet(c, promoteCksumFlag),
 	}
 	xid, err := api.Promote(apiBP, bck, &args)
 	if err != nil {
@@ -51,17 +59,119 @@ func promote(c *cli.Context, bck cmn.Bck, objName, fqn string) error {
 	if xid != "" {
 		s2 = fmt.Sprintf(", xaction ID %q", xid)
 	}
+	if flagIsSet(c, verifyDeleteFlag) {
+		return verifyPromoted(c, bck, fqn, xid, recurs)
+	}
 	// alternatively, print(fmtXactStatusCheck, apc.ActPromote, ...)
 	msg := fmt.Sprintf("%spromoted %q => %s%s\n", s1, fqn, bck.Cname(""), s2)
 	actionDone(c, msg)
 	return nil
 }
 
+// (--verify-delete) wait for the promote xaction (if any), report its stats,
+// and check the source for leftovers
+func verifyPromoted(c *cli.Context, bck cmn.Bck, fqn, xid string, recurs bool) error {
+	var (
+		promoted int64
+		size     int64
+		errs     []string
+	)
+	if xid != "" {
+		xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActPromote}
+		if err := waitXact(&xargs); err != nil {
+			return err
+		}
+		xs, err := api.QueryXactionSnaps(apiBP, &xargs)
+		if err != nil {
+			return V(err)
+		}
+		promoted, _, _ = xs.ObjCounts(xid)
+		size, _, _ = xs.ByteCounts(xid)
+		for _, snaps := range xs {
+			for _, snap := range snaps {
+				if snap.ID == xid && snap.Err != "" {
+					errs = append(errs, snap.Err)
+				}
+			}
+		}
+	}
+
+	// NOTE: the source is expected to be accessible from this host (e.g., shared filesystem);
+	// otherwise, the number below reflects only the local view
+	remaining, err := countSrcFiles(fqn, recurs)
+	if err != nil {
+		return err
+	}
+	var msg string
+	if xid == "" {
+		// synchronous (e.g., single-file) promotion: no job, no stats
+		msg = fmt.Sprintf("promoted %q => %s", fqn, bck.Cname(""))
+	} else {
+		msg = fmt.Sprintf("promoted %q => %s: %d object%s", fqn, bck.Cname(""), promoted, cos.Plural(int(promoted)))
+		if size > 0 {
+			msg += " (" + cos.ToSizeIEC(size, 2) + ")"
+		}
+		msg += fmt.Sprintf(", xaction ID %q", xid)
+	}
+	actionDone(c, msg)
+
+	for _, e := range errs {
+		actionWarn(c, e)
+	}
+	if remaining > 0 {
+		actionWarn(c, fmt.Sprintf("%q: %d source file%s not deleted", fqn, remaining, cos.Plural(remaining)))
+	} else {
+		actionNote(c, fmt.Sprintf("%q: no source files remaining", fqn))
+	}
+	return nil
+}
+
+// count regular files that still exist at the promoted source
+func countSrcFiles(fqn string, recurs bool) (int, error) {
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if !finfo.IsDir() {
+		return 1, nil
+	}
+	var n int
+	err = filepath.WalkDir(fqn, func(path string, de fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if de.IsDir() {
+			if path != fqn && !recurs {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if de.Type().IsRegular() {
+			n++
+		}
+		return nil
+	})
+	return n, err
+}
+
 func setCustomProps(c *cli.Context, bck cmn.Bck, objName string) (err error) {
 	props := make(cos.StrKVs)
 	propArgs := c.Args().Tail()
 
-	if len(propArgs) == 1 && isJSON(propArgs[0]) {
+	if flagIsSet(c, setCustomFromFileFlag) {
+		if len(propArgs) > 0 {
+			return fmt.Errorf("option %s cannot be used with command-line properties (%v)", qflprn(setCustomFromFileFlag), propArgs)
+		}
+		if props, err = loadCustomProps(parseStrFlag(c, setCustomFromFileFlag)); err != nil {
+			return err
+		}
+	} else if len(propArgs) == 1 && isJSON(propArgs[0]) {
 		if err = jsoniter.Unmarshal([]byte(propArgs[0]), &props); err != nil {
 			return
 		}
@@ -81,6 +191,12 @@ func setCustomProps(c *cli.Context, bck cmn.Bck, objName string) (err error) {
 		}
 	}
 	setNewCustom := flagIsSet(c, setNewCustomMDFlag)
+	if flagIsSet(c, listFlag) || flagIsSet(c, templateFlag) {
+		if objName != "" {
+			return fmt.Errorf("object name (%q) cannot be used with %s or %s", objName, qflprn(listFlag), qflprn(templateFlag))
+		}
+		return setCustomPropsMulti(c, bck, props, setNewCustom)
+	}
 	if err = api.SetObjectCustomProps(apiBP, bck, objName, props, setNewCustom); err != nil {
 		return
 	}
@@ -132,6 +248,31 @@ func verbRange(c *cli.Context, wop wop, pt *cos.ParsedTemplate, bck cmn.Bck, tri
 	return verbFobjs(c, wop, allFobjs, bck, ndir, recurs)
 }
 
+// print the resolved (sorted) order of files to compose, and the resulting size
+func concatDryRun(c *cli.Context, fobjMatrix []fobjs, name string, totalSize int64) {
+	const verb = "Compose"
+	var cnt int
+	units, errU := parseUnitsFlag(c, unitsFlag)
+	if errU != nil {
+		actionWarn(c, errU.Error())
+		units = ""
+	}
+	dryRunCptn(c)
+	for _, fsl := range fobjMatrix {
+		for _, f := range fsl {
+			if cnt < dryRunExamplesCnt {
+				fmt.Fprintf(c.App.Writer, "%s %s [%s]\n", verb, f.path, teb.FmtSize(f.size, units, 2))
+			}
+			cnt++
+		}
+	}
+	if cnt > dryRunExamplesCnt {
+		fmt.Fprintf(c.App.Writer, "(and %d more)\n", cnt-dryRunExamplesCnt)
+	}
+	fmt.Fprintf(c.App.Writer, "%d file%s => %s, total size %s\n", cnt, cos.Plural(cnt), name,
+		teb.FmtSize(totalSize, units, 2))
+}
+
 func concatObject(c *cli.Context, bck cmn.Bck, objName string, fileNames []string) error {
 	const verb = "Compose"
 	var (
@@ -145,19 +286,29 @@ func concatObject(c *cli.Context, bck cmn.Bck, objName string, fileNames []strin
 		sizes      = make(map[string]int64, l) // or greater
 		name       = bck.Cname(objName)
 		recurs     = flagIsSet(c, recursFlag)
+		orderBy    = parseStrFlag(c, concatOrderByFlag)
 	)
+	if orderBy == "" {
+		orderBy = orderByName // (e.g., 'ais put --append')
+	}
 	for i, fileName := range fileNames {
 		fobjs, err := lsFobj(c, fileName, "", "", &ndir, recurs, false /*incl src dir*/)
 		if err != nil {
 			return err
 		}
-		sort.Sort(fobjs)
+		if err := fobjs.sortBy(orderBy); err != nil {
+			return err
+		}
 		for _, f := range fobjs {
 			totalSize += f.size
 			sizes[f.path] = f.size
 		}
 		fobjMatrix[i] = fobjs
 	}
+	if flagIsSet(c, dryRunFlag) {
+		concatDryRun(c, fobjMatrix, name, totalSize)
+		return nil
+	}
 	// setup progress bar
 	if flagIsSet(c, progressFlag) {
 		switch l {
@@ -178,27 +329,33 @@ func concatObject(c *cli.Context, bck cmn.Bck, objName string, fileNames []strin
 		bar = bars[0]
 	}
 	// do
-	var handle string
-	for _, fsl := range fobjMatrix {
-		for _, f := range fsl {
-			fh, err := cos.NewFileHandle(f.path)
-			if err != nil {
-				return err
-			}
-			appendArgs := api.AppendArgs{
-				BaseParams: apiBP,
-				Bck:        bck,
-				Object:     objName,
-				Reader:     fh,
-				Handle:     handle,
-			}
-			handle, err = api.AppendObject(&appendArgs)
-			if err != nil {
-				return fmt.Errorf("%v. Object not created", err)
-			}
-			if bar != nil {
-				bar.IncrInt64(sizes[f.path])
-			}
+	var (
+		handle string
+		parts  = concatParts(fobjMatrix, flagIsSet(c, concatPipelineFlag))
+	)
+	defer parts.stop()
+	for {
+		part, ok := <-parts.ch
+		if !ok {
+			break
+		}
+		if part.err != nil {
+			return part.err
+		}
+		appendArgs := api.AppendArgs{
+			BaseParams: apiBP,
+			Bck:        bck,
+			Object:     objName,
+			Reader:     part.r,
+			Handle:     handle,
+		}
+		var err error
+		handle, err = api.AppendObject(&appendArgs)
+		if err != nil {
+			return fmt.Errorf("%v. Object not created", err)
+		}
+		if bar != nil {
+			bar.IncrInt64(sizes[part.path])
 		}
 	}
 
@@ -220,10 +377,75 @@ func concatObject(c *cli.Context, bck cmn.Bck, objName string, fileNames []strin
 		actionWarn(c, errU.Error())
 		units = ""
 	}
-	fmt.Fprintf(c.App.Writer, "\nCreated %s (size %s)\n", name, teb.FmtSize(totalSize, units, 2))
+	fmt.Fprintf(c.App.Writer, "\nCreated %s (size %s, files ordered by %s)\n", name, teb.FmtSize(totalSize, units, 2), orderBy)
 	return nil
 }
 
+// concatenation sources, in order:
+// a separate goroutine opens the next file while the current one is being appended
+// and, with '--pipeline', also reads it into memory (unless too large);
+// the channel is unbuffered, so that the appending order (and the append handle sequence)
+// is preserved, with at most one file read ahead
+type (
+	concatPart struct {
+		r    cos.ReadOpenCloser
+		err  error
+		path string
+	}
+	concatPipe struct {
+		ch   chan concatPart
+		done chan struct{}
+	}
+)
+
+const concatPreReadMax = 64 * cos.MiB // larger files are not pre-read - only opened ahead
+
+func concatParts(fobjMatrix []fobjs, pipeline bool) *concatPipe {
+	pipe := &concatPipe{ch: make(chan concatPart), done: make(chan struct{})}
+	next := func(f fobj) (part concatPart) {
+		part.path = f.path
+		fh, err := cos.NewFileHandle(f.path)
+		if err != nil {
+			part.err = err
+			return part
+		}
+		if !pipeline || f.size > concatPreReadMax {
+			part.r = fh
+			return part
+		}
+		b, err := io.ReadAll(fh)
+		fh.Close()
+		if err != nil {
+			part.err = err
+		} else {
+			part.r = cos.NewByteHandle(b)
+		}
+		return part
+	}
+	go func() {
+		defer close(pipe.ch)
+		for _, fsl := range fobjMatrix {
+			for _, f := range fsl {
+				part := next(f)
+				select {
+				case pipe.ch <- part:
+				case <-pipe.done:
+					if part.r != nil {
+						part.r.Close()
+					}
+					return
+				}
+				if part.err != nil {
+					return
+				}
+			}
+		}
+	}()
+	return pipe
+}
+
+func (pipe *concatPipe) stop() { close(pipe.done) }
+
 func isObjPresent(c *cli.Context, bck cmn.Bck, objName string) error {
 	name := bck.Cname(objName)
 	_, err := api.HeadObject(apiBP, bck, objName, apc.FltPresentNoProps, true)
@@ -309,6 +531,9 @@ func showObjProps(c *cli.Context, bck cmn.Bck, objName string) error {
 			propNVs = append(propNVs, nvpair{name, v})
 		}
 	}
+	if v := fmtTTL(objProps); v != "" {
+		propNVs = append(propNVs, nvpair{"ttl", v})
+	}
 	sort.Slice(propNVs, func(i, j int) bool {
 		return propNVs[i].Name < propNVs[j].Name
 	})
@@ -326,7 +551,7 @@ func propVal(op *cmn.ObjectProps, name string) (v string) {
 	case apc.GetPropsSize:
 		v = cos.ToSizeIEC(op.Size, 2)
 	case apc.GetPropsChecksum:
-		v = op.Cksum.String()
+		v = fmtCksums(op)
 	case apc.GetPropsAtime:
 		v = cos.FormatNanoTime(op.Atime, "")
 	case apc.GetPropsVersion:
@@ -345,10 +570,16 @@ func propVal(op *cmn.ObjectProps, name string) (v string) {
 	case apc.GetPropsEC:
 		v = teb.FmtEC(op.EC.Generation, op.EC.DataSlices, op.EC.ParitySlices, op.EC.IsECCopy)
 	case apc.GetPropsCustom:
-		if custom := op.GetCustomMD(); len(custom) == 0 {
+		custom, tags := splitS3Tags(op.GetCustomMD())
+		switch {
+		case len(custom) == 0 && tags == "":
 			v = teb.NotSetVal
-		} else {
+		case tags == "":
 			v = cmn.CustomMD2S(custom)
+		case len(custom) == 0:
+			v = tags
+		default:
+			v = cmn.CustomMD2S(custom) + " " + tags
 		}
 	case apc.GetPropsLocation:
 		v = op.Location
@@ -360,7 +591,70 @@ func propVal(op *cmn.ObjectProps, name string) (v string) {
 	return
 }
 
+// digests that may be stored as custom metadata (e.g., when ingesting via S3 or from a remote backend)
+var cksumObjMD = []string{cmn.MD5ObjMD, cmn.CRC32CObjMD, cmn.ETag}
+
+// native checksum followed by the digests above, if present and different
+func fmtCksums(op *cmn.ObjectProps) string {
+	v := op.Cksum.String()
+	for _, key := range cksumObjMD {
+		d, ok := op.GetCustomKey(key)
+		if d = strings.Trim(d, "\""); !ok || d == "" {
+			continue
+		}
+		if !op.Cksum.IsEmpty() && op.Cksum.Ty() == key && op.Cksum.Val() == d {
+			continue
+		}
+		v += ", " + strings.ToLower(key) + "[" + cos.SHead(d) + "]"
+	}
+	return v
+}
+
+// remaining time-to-live of the object that has expiration time (see `putTTLFlag`)
+func fmtTTL(op *cmn.ObjectProps) string {
+	v, ok := op.GetCustomKey(cmn.ExpiresAtObjMD)
+	if !ok {
+		return ""
+	}
+	exp, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return teb.NotSetVal
+	}
+	left := time.Until(exp)
+	if left <= 0 {
+		return "expired (" + v + ")"
+	}
+	return left.Truncate(time.Second).String() + " (expires " + v + ")"
+}
+
+// S3 object tags (custom keys prefixed with `cmn.S3TagObjMD`) are shown separately,
+// e.g.: "tags[env=prod project=alpha]"
+func splitS3Tags(md cos.StrKVs) (custom cos.StrKVs, tags string) {
+	var kvs []string
+	for k, v := range md {
+		if key, ok := strings.CutPrefix(k, cmn.S3TagObjMD); ok {
+			kvs = append(kvs, key+"="+v)
+			continue
+		}
+		if custom == nil {
+			custom = make(cos.StrKVs, len(md))
+		}
+		custom[k] = v
+	}
+	if len(kvs) > 0 {
+		sort.Strings(kvs)
+		tags = "tags[" + strings.Join(kvs, " ") + "]"
+	}
+	return custom, tags
+}
+
 func rmRfAllObjects(c *cli.Context, bck cmn.Bck) error {
+	numWorkers := sys.NumCPU()
+	if flagIsSet(c, rmNumWorkersFlag) {
+		if numWorkers = parseIntFlag(c, rmNumWorkersFlag); numWorkers <= 0 {
+			return fmt.Errorf("invalid %s=%d: expecting a positive number", qflprn(rmNumWorkersFlag), numWorkers)
+		}
+	}
 	objList, err := api.ListObjects(apiBP, bck, nil, api.ListArgs{})
 	if err != nil {
 		return err
@@ -371,20 +665,32 @@ func rmRfAllObjects(c *cli.Context, bck cmn.Bck) error {
 		return nil
 	}
 
+	// Ctrl-C: stop issuing new deletes, wait for those in flight
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	var (
 		errCh    = make(chan error, 1)
 		cnt64    int64
 		errCnt64 int64
 		progress int64
+		issued   int
 		period   int64 = 1000
-		wg             = cos.NewLimitedWaitGroup(sys.NumCPU(), l)
+		wg             = cos.NewLimitedWaitGroup(numWorkers, l)
 		vrbs           = flagIsSet(c, verboseFlag)
 	)
 	if bck.IsCloud() {
 		period = 100
 	}
+outer:
 	for _, entry := range objList.Entries {
+		select {
+		case <-ctx.Done():
+			break outer
+		default:
+		}
 		wg.Add(1)
+		issued++
 		// delete one
 		go func(objName string) {
 			err := api.DeleteObject(apiBP, bck, objName)
@@ -410,18 +716,30 @@ func rmRfAllObjects(c *cli.Context, bck cmn.Bck) error {
 	if ratomic.LoadInt64(&progress) > 0 {
 		fmt.Fprintln(c.App.Writer)
 	}
-	cnt := int(cnt64)
+	var (
+		cnt    = int(cnt64)
+		errCnt = int(errCnt64)
+	)
 	if cnt == l {
-		debug.Assert(errCnt64 == 0)
+		debug.Assert(errCnt == 0)
 		msg := fmt.Sprintf("Deleted %s object%s from %s\n", cos.FormatBigNum(cnt), cos.Plural(cnt), bck.Cname(""))
 		actionDone(c, msg)
 		return nil
 	}
+	if issued < l {
+		err := fmt.Errorf("interrupted: deleted %s out of %s object%s from %s (%s remaining, %d error%s)",
+			cos.FormatBigNum(cnt), cos.FormatBigNum(l), cos.Plural(l), bck.Cname(""), cos.FormatBigNum(l-cnt),
+			errCnt, cos.Plural(errCnt))
+		if errCnt > 0 {
+			err = fmt.Errorf("%v: %v", err, <-errCh)
+		}
+		return err
+	}
 
-	debug.Assert(errCnt64 > 0)
+	debug.Assert(errCnt > 0)
 	firstErr := <-errCh
 	warn := fmt.Sprintf("failed to delete %d object%s from %s: (%d deleted, %d error%s)\n", l-cnt, cos.Plural(l-cnt),
-		bck, cnt, errCnt64, cos.Plural(int(errCnt64)))
+		bck.Cname(""), cnt, errCnt, cos.Plural(errCnt))
 	actionWarn(c, warn)
 	return firstErr
 }
@@ -27,29 +27,46 @@ var (
 			listRangeProgressWaitFlags,
 			verbObjPrefixFlag, // to disambiguate bucket/prefix vs bucket/objName
 			rmrfFlag,
-			verboseFlag, // rm -rf
+			rmNumWorkersFlag, // ditto
+			verboseFlag,      // ditto
 			nonverboseFlag,
 			yesFlag,
+			continueOnErrorFlag,
+			rmOlderThanFlag,
+			dryRunFlag,
 		),
-		commandRename: {},
+		commandRename:  {},
+		commandRestore: {},
 		commandGet: {
 			offsetFlag,
 			lengthFlag,
 			cksumFlag,
+			chunkValidateFlag,
+			getRetryCksumFlag,
+			headFirstFlag,
+			decompressFlag,
+			checksumOnlyFlag,
 			yesFlag,
 			headObjPresentFlag,
 			latestVerFlag,
 			refreshFlag,
 			progressFlag,
+			resumeFlag,
 			// blob-downloader
 			blobDownloadFlag,
 			chunkSizeFlag,
-			numWorkersFlag,
+			getNumWorkersFlag, // ditto, and multi-object
 			// archive
 			archpathGetFlag,
+			archGlobFlag,
 			extractFlag,
 			// multi-object options (passed to list-objects)
 			getObjPrefixFlag,
+			listFlag,
+			templateFlag,
+			getToDirFlag,
+			getArchFormatFlag,
+			continueOnErrorFlag,
 			getObjCachedFlag,
 			listArchFlag,
 			objLimitFlag,
@@ -62,34 +79,62 @@ var (
 		commandPut: append(
 			listRangeProgressWaitFlags,
 			chunkSizeFlag,
+			putNumWorkersFlag,
 			concurrencyFlag,
+			putRetriesFlag,
+			putRetryBackoffFlag,
 			dryRunFlag,
 			recursFlag,
 			verboseFlag,
 			yesFlag,
 			continueOnErrorFlag,
 			unitsFlag,
+			flatFlag,
+			skipExistingFlag,
+			putStoreMD5Flag,
+			putMmapFlag,
+			putTTLFlag,
+			// filter source files
+			fileMinSizeFlag,
+			fileMaxSizeFlag,
+			fileNewerThanFlag,
+			fileOlderThanFlag,
 			// cksum
 			skipVerCksumFlag,
 			putObjDfltCksumFlag,
+			putObjCksumTypeFlag,
 			// append
 			appendConcatFlag,
+			putAppendIfExistsFlag,
 		),
 		commandSetCustom: {
 			setNewCustomMDFlag,
+			setCustomFromFileFlag,
+			listFlag,
+			templateFlag,
+			setCustomNumWorkersFlag,
+			continueOnErrorFlag,
+			yesFlag,
 		},
 		commandPromote: {
 			recursFlag,
 			overwriteFlag,
 			notFshareFlag,
 			deleteSrcFlag,
+			verifyDeleteFlag,
+			promoteCksumFlag,
 			targetIDFlag,
 			verboseFlag,
 		},
 		commandConcat: {
 			recursFlag,
+			concatOrderByFlag,
+			concatPipelineFlag,
 			unitsFlag,
 			progressFlag,
+			dryRunFlag,
+			chunkSizeFlag,
+			verboseFlag,
 		},
 		commandCat: {
 			offsetFlag,
@@ -107,6 +152,7 @@ var (
 			indent4 + "\twrite the content locally with destination options including: filename, directory, STDOUT ('-'), or '/dev/null' (discard);\n" +
 			indent4 + "\tassorted options further include:\n" +
 			indent4 + "\t- '--prefix' to get multiple objects in one shot (empty prefix for the entire bucket);\n" +
+			indent4 + "\t- '--list' or '--template' to get multiple named or matching objects ('--num-workers' to parallelize);\n" +
 			indent4 + "\t- '--extract' or '--archpath' to extract archived content;\n" +
 			indent4 + "\t- '--progress' and '--refresh' to watch progress bar;\n" +
 			indent4 + "\t- '-v' to produce verbose output when getting multiple objects.",
@@ -129,6 +175,7 @@ var (
 			indent1 + "\t- '--progress': progress bar, to show running counts and sizes of uploaded files;\n" +
 			indent1 + "\t- Ctrl-D: when writing directly from standard input use Ctrl-D to terminate;\n" +
 			indent1 + "\t- '--append' to append (concatenate) files, e.g.: 'ais put docs ais://nnn/all-docs --append';\n" +
+			indent1 + "\t- '--chunk-size' and '--num-workers': upload a large file in parallel parts (multipart upload);\n" +
 			indent1 + "\t- '--dry-run': see the results without making any changes.\n" +
 			indent1 + "\tNotes:\n" +
 			indent1 + "\t- to write or add files to " + archExts + "-formatted objects (\"shards\"), use 'ais archive'",
@@ -159,7 +206,9 @@ var (
 		Name: commandConcat,
 		Usage: "append a file, a directory, or multiple files and/or directories\n" +
 			indent1 + "as a new " + objectArgument + " if doesn't exists, and to an existing " + objectArgument + " otherwise, e.g.:\n" +
-			indent1 + "$ ais object concat docs ais://nnn/all-docs ### concatenate all files from docs/ directory.",
+			indent1 + "$ ais object concat docs ais://nnn/all-docs ### concatenate all files from docs/ directory.\n" +
+			indent1 + "Use '-' to append standard input (in '--chunk-size' chunks), e.g.:\n" +
+			indent1 + "$ tail -f app.log | ais object concat - ais://nnn/all-logs",
 		ArgsUsage: concatObjectArgument,
 		Flags:     objectCmdsFlags[commandConcat],
 		Action:    concatHandler,
@@ -189,6 +238,7 @@ var (
 			indent1 + "\t- 'rm s3://abc' --all\t- remove all objects including those that are not _present_ in the cluster;\n" +
 			indent1 + "\t- 'rm gs://abc --template images/'\t- remove all objects from the virtual subdirectory \"images\";\n" +
 			indent1 + "\t- 'rm gs://abc/images/'\t- same as above;\n" +
+			indent1 + "\t- 'rm gs://abc --prefix images/'\t- same as above (deletes server-side and prints the job ID);\n" +
 			indent1 + "\t- 'rm gs://abc --template \"shard-{0000..9999}.tar.lz4\"'\t- remove the matching range (prefix + brace expansion);\n" +
 			indent1 + "\t- 'rm \"gs://abc/shard-{0000..9999}.tar.lz4\"'\t- same as above (notice double quotes)",
 		ArgsUsage:    bucketObjectOrTemplateMultiArg,
@@ -220,6 +270,16 @@ var (
 				Action:       mvObjectHandler,
 				BashComplete: bucketCompletions(bcmplop{multiple: true, separator: true}),
 			},
+			{
+				Name: commandRestore,
+				Usage: "restore deleted object (requires soft delete enabled in the cluster configuration), e.g.:\n" +
+					indent1 + "\t- 'ais config cluster space.trash_ttl 24h'\t- enable soft delete: keep deleted objects in the trash for 24 hours;\n" +
+					indent1 + "\t- 'ais object restore ais://nnn/aaa'\t- restore deleted ais://nnn/aaa (unless expired or overwritten since)",
+				ArgsUsage:    objectArgument,
+				Flags:        objectCmdsFlags[commandRestore],
+				Action:       restoreObjectHandler,
+				BashComplete: bucketCompletions(bcmplop{separator: true}),
+			},
 			{
 				Name:         commandCat,
 				Usage:        "cat an object (i.e., print its contents to STDOUT)",
@@ -279,19 +339,74 @@ func mvObjectHandler(c *cli.Context) (err error) {
 	return
 }
 
+func restoreObjectHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if c.NArg() > 1 {
+		return incorrectUsageMsg(c, "", c.Args()[1:])
+	}
+	uri := c.Args().Get(0)
+	bck, objName, err := parseBckObjURI(c, uri, false)
+	if err != nil {
+		return err
+	}
+	if objName == "" {
+		return incorrectUsageMsg(c, "no object specified in %q", uri)
+	}
+	if !bck.IsAIS() {
+		return incorrectUsageMsg(c, "provider %q not supported (soft delete applies to ais:// buckets only)", bck.Provider)
+	}
+	if err := api.RestoreObject(apiBP, bck, objName); err != nil {
+		if cmn.IsStatusNotFound(err) {
+			return fmt.Errorf("%s not found in trash (never deleted, expired, or soft delete disabled)", bck.Cname(objName))
+		}
+		return V(err)
+	}
+	actionDone(c, "Restored "+bck.Cname(objName))
+	return nil
+}
+
 // main PUT handler: cases 1 through 4
 func putHandler(c *cli.Context) error {
 	if flagIsSet(c, appendConcatFlag) {
 		return concatHandler(c)
 	}
 
+	if flagIsSet(c, putTTLFlag) {
+		if ttl := parseDurationFlag(c, putTTLFlag); ttl <= 0 {
+			return fmt.Errorf("invalid %s=%v: expecting positive duration, e.g. '90m' or '24h'", flprn(putTTLFlag), ttl)
+		}
+		// (expiration is computed at upload time, and appended-to objects are never removed - see storeTTL)
+		if flagIsSet(c, putAppendIfExistsFlag) {
+			return fmt.Errorf(errFmtExclusive, qflprn(putTTLFlag), qflprn(putAppendIfExistsFlag))
+		}
+	}
+	if flagIsSet(c, putStoreMD5Flag) {
+		switch {
+		case flagIsSet(c, putAppendIfExistsFlag):
+			return fmt.Errorf(errFmtExclusive, qflprn(putStoreMD5Flag), qflprn(putAppendIfExistsFlag))
+		case flagIsSet(c, chunkSizeFlag):
+			return fmt.Errorf(errFmtExclusive, qflprn(putStoreMD5Flag), qflprn(chunkSizeFlag))
+		case flagIsSet(c, putObjCksumTypeFlag) || flagIsSet(c, putObjDfltCksumFlag) || len(altCksumToComp(c)) > 0:
+			// (multi-checksum is not supported yet - see cksumToCompute)
+			return fmt.Errorf("option %s cannot be used together with other checksum options", qflprn(putStoreMD5Flag))
+		}
+	}
 	var a putargs
 	if err := a.parse(c, true /*empty dst oname*/); err != nil {
 		return err
 	}
+	if a.src.stdin && flagIsSet(c, putStoreMD5Flag) {
+		// (standard input is written in appended chunks)
+		return fmt.Errorf("option %s is not supported when writing standard input", qflprn(putStoreMD5Flag))
+	}
 	if flagIsSet(c, dryRunFlag) {
 		dryRunCptn(c)
 	}
+	if flagIsSet(c, putAppendIfExistsFlag) && !a.srcIsRegular() && !a.src.stdin {
+		return fmt.Errorf("option %s requires a single source file or standard input", qflprn(putAppendIfExistsFlag))
+	}
 
 	// 1. one file
 	if a.srcIsRegular() {
@@ -299,10 +414,19 @@ func putHandler(c *cli.Context) error {
 		if cos.IsLastB(a.dst.oname, '/') {
 			a.dst.oname += a.src.arg
 		}
-		if err := putRegular(c, a.dst.bck, a.dst.oname, a.src.abspath, a.src.finfo); err != nil {
+		verb := a.verb()
+		if flagIsSet(c, putAppendIfExistsFlag) && !flagIsSet(c, dryRunFlag) {
+			appended, err := putAppendIfExists(c, a.dst.bck, a.dst.oname, a.src.abspath, a.src.finfo)
+			if err != nil {
+				return err
+			}
+			if appended {
+				verb = "APPEND"
+			}
+		} else if err := putRegular(c, a.dst.bck, a.dst.oname, a.src.abspath, a.src.finfo); err != nil {
 			return err
 		}
-		actionDone(c, fmt.Sprintf("%s %q => %s\n", a.verb(), a.src.arg, a.dst.bck.Cname(a.dst.oname)))
+		actionDone(c, fmt.Sprintf("%s %q => %s\n", verb, a.src.arg, a.dst.bck.Cname(a.dst.oname)))
 		return nil
 	}
 
@@ -380,13 +504,13 @@ func warnMultiSrcDstPrefix(c *cli.Context, a *putargs, from string) bool {
 	return true
 }
 
-func putStdin(c *cli.Context, a *putargs) error {
+func stdinChunkSize(c *cli.Context) (int64, error) {
 	chunkSize, err := parseSizeFlag(c, chunkSizeFlag)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	if flagIsSet(c, chunkSizeFlag) && chunkSize == 0 {
-		return fmt.Errorf("chunk size (in %s) cannot be zero (%s recommended)",
+		return 0, fmt.Errorf("chunk size (in %s) cannot be zero (%s recommended)",
 			qflprn(chunkSizeFlag), teb.FmtSize(dfltStdinChunkSize, cos.UnitsIEC, 0))
 	}
 	if chunkSize == 0 {
@@ -395,14 +519,59 @@ func putStdin(c *cli.Context, a *putargs) error {
 	if flagIsSet(c, verboseFlag) {
 		actionWarn(c, "To terminate input, press Ctrl-D two or more times")
 	}
+	return chunkSize, nil
+}
+
+func putStdin(c *cli.Context, a *putargs) error {
+	chunkSize, err := stdinChunkSize(c)
+	if err != nil {
+		return err
+	}
 	cksum, err := cksumToCompute(c, a.dst.bck)
 	if err != nil {
 		return err
 	}
-	if err := putAppendChunks(c, a.dst.bck, a.dst.oname, os.Stdin, cksum.Type(), chunkSize); err != nil {
+	var (
+		verb, ckty = "PUT", cksum.Type()
+		apnd       bool
+	)
+	if flagIsSet(c, putAppendIfExistsFlag) {
+		if apnd, err = objExists(a.dst.bck, a.dst.oname); err != nil {
+			return err
+		}
+		if apnd {
+			verb, ckty = "APPEND", cos.ChecksumNone // (as in appendStdin)
+		}
+	}
+	if err := putAppendChunks(c, a.dst.bck, a.dst.oname, os.Stdin, ckty, chunkSize, apnd); err != nil {
 		return err
 	}
-	actionDone(c, fmt.Sprintf("PUT (standard input) => %s\n", a.dst.bck.Cname(a.dst.oname)))
+	if ttl := putTTL(c); ttl > 0 {
+		if err := storeTTL(a.dst.bck, a.dst.oname, ttl); err != nil {
+			return err
+		}
+	}
+	actionDone(c, fmt.Sprintf("%s (standard input) => %s\n", verb, a.dst.bck.Cname(a.dst.oname)))
+	return nil
+}
+
+// append standard input to an existing object (or create a new one), e.g.:
+// $ tail -f app.log | ais object concat - ais://nnn/all-logs
+// NOTE: the object's checksum is computed by the cluster (the client only sees appended bytes)
+func appendStdin(c *cli.Context, bck cmn.Bck, objName string) error {
+	if flagIsSet(c, dryRunFlag) {
+		dryRunCptn(c)
+		fmt.Fprintf(c.App.Writer, "APPEND (standard input) => %s\n", bck.Cname(objName))
+		return nil
+	}
+	chunkSize, err := stdinChunkSize(c)
+	if err != nil {
+		return err
+	}
+	if err := putAppendChunks(c, bck, objName, os.Stdin, cos.ChecksumNone, chunkSize, true /*append*/); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("APPEND (standard input) => %s\n", bck.Cname(objName)))
 	return nil
 }
 
@@ -430,6 +599,9 @@ func concatHandler(c *cli.Context) (err error) {
 	if _, err = headBucket(bck, false /* don't add */); err != nil {
 		return
 	}
+	if len(fileNames) == 1 && fileNames[0] == fileStdIO {
+		return appendStdin(c, bck, objName)
+	}
 	return concatObject(c, bck, objName, fileNames)
 }
 
@@ -445,6 +617,9 @@ func promoteHandler(c *cli.Context) (err error) {
 	if c.NArg() < 2 {
 		return missingArgumentsError(c, "destination in the form "+optionalObjectsArgument)
 	}
+	if flagIsSet(c, verifyDeleteFlag) && !flagIsSet(c, deleteSrcFlag) {
+		return fmt.Errorf("option %s requires %s", qflprn(verifyDeleteFlag), qflprn(deleteSrcFlag))
+	}
 
 	var (
 		bck         cmn.Bck
@@ -7,6 +7,7 @@ package cli
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -32,8 +33,16 @@ type (
 	}
 )
 
-func simpleBar(args ...barArgs) (progress *mpb.Progress, bars []*mpb.Bar) {
-	progress = mpb.New(mpb.WithWidth(barWidth))
+func simpleBar(args ...barArgs) (*mpb.Progress, []*mpb.Bar) {
+	return _simpleBar(mpb.New(mpb.WithWidth(barWidth)), args)
+}
+
+// same as above, with a given output (e.g., standard error when standard output carries data)
+func simpleBarTo(w io.Writer, args ...barArgs) (*mpb.Progress, []*mpb.Bar) {
+	return _simpleBar(mpb.New(mpb.WithWidth(barWidth), mpb.WithOutput(w)), args)
+}
+
+func _simpleBar(progress *mpb.Progress, args []barArgs) (_ *mpb.Progress, bars []*mpb.Bar) {
 	bars = make([]*mpb.Bar, 0, len(args))
 
 	for _, a := range args {
@@ -61,7 +70,7 @@ func simpleBar(args ...barArgs) (progress *mpb.Progress, bars []*mpb.Bar) {
 		)
 		bars = append(bars, progress.AddBar(a.total, options...))
 	}
-	return
+	return progress, bars
 }
 
 ///////////////////
@@ -5,8 +5,10 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -41,6 +43,10 @@ func copyBucketHandler(c *cli.Context) (err error) {
 	case c.NArg() == 1:
 		bckFrom, objFrom, err = parseBckObjURI(c, c.Args().Get(0), true /*emptyObjnameOK*/)
 	default:
+		// single object, server-side: 'ais cp BUCKET/OBJECT BUCKET2/OBJECT2'
+		if bck, objTo, errV := parseBckObjURI(c, c.Args().Get(1), true /*emptyObjnameOK*/); errV == nil && objTo != "" {
+			return copyObject(c, bck, objTo)
+		}
 		bckFrom, bckTo, objFrom, err = parseBcks(c, bucketSrcArgument, bucketDstArgument, 0 /*shift*/, true /*optionalSrcObjname*/)
 	}
 	if err != nil {
@@ -68,6 +74,35 @@ func copyBucketHandler(c *cli.Context) (err error) {
 	return copyTransform(c, "" /*etlName*/, objFrom, bckFrom, bckTo, flagIsSet(c, copyAllObjsFlag))
 }
 
+// copy a single named object to a (possibly, differently) named destination
+func copyObject(c *cli.Context, bckTo cmn.Bck, objNameTo string) error {
+	uri := c.Args().Get(0)
+	bckFrom, objName, err := parseBckObjURI(c, uri, false /*emptyObjnameOK*/)
+	if err != nil {
+		return err
+	}
+	if isPattern(objName) || flagIsSet(c, listFlag) || flagIsSet(c, templateFlag) || flagIsSet(c, verbObjPrefixFlag) {
+		return incorrectUsageMsg(c, "destination object name (%s) requires a single source object (got %s)",
+			bckTo.Cname(objNameTo), uri)
+	}
+	if c.NArg() > 2 {
+		return incorrectUsageMsg(c, "too many arguments %v", c.Args()[2:])
+	}
+	from, to := bckFrom.Cname(objName), bckTo.Cname(objNameTo)
+	if flagIsSet(c, copyDryRunFlag) {
+		dryRunCptn(c)
+		actionDone(c, "Copying "+from+" => "+to)
+		return nil
+	}
+	if err := api.CopyObject(apiBP, bckFrom, objName, bckTo, objNameTo, flagIsSet(c, latestVerFlag)); err != nil {
+		return V(err)
+	}
+	if !flagIsSet(c, nonverboseFlag) {
+		actionDone(c, "Copied "+from+" => "+to)
+	}
+	return nil
+}
+
 //
 // main function: (cp | etl) & (bucket | multi-object)
 //
@@ -113,8 +148,27 @@ func copyTransform(c *cli.Context, etlName, objNameOrTmpl string, bckFrom, bckTo
 
 	dryRun := flagIsSet(c, copyDryRunFlag)
 
+	isBck := objName == "" && listObjs == "" && tmplObjs == ""
+	for _, f := range []cli.Flag{copyRegexFlag, copyFilterCustomFlag} {
+		if !flagIsSet(c, f) {
+			continue
+		}
+		// regex and custom filter apply to bucket-to-bucket copy, with '--prefix' (if any) applied first
+		if !isBck && (objName != "" || listObjs != "" || !flagIsSet(c, verbObjPrefixFlag)) {
+			return fmt.Errorf("option %s cannot be used with %s, %s, or (source) object name - use %s to narrow the selection",
+				qflprn(f), qflprn(listFlag), qflprn(templateFlag), qflprn(verbObjPrefixFlag))
+		}
+		isBck = true
+	}
+
 	// either 1. copy/transform bucket (x-tcb)
-	if objName == "" && listObjs == "" && tmplObjs == "" {
+	if isBck {
+		for _, fl := range []cli.Flag{copyNumWorkersFlag, copyBatchSizeFlag} {
+			if flagIsSet(c, fl) {
+				return fmt.Errorf("option %s applies to multi-object copy only (%s, %s)", qflprn(fl),
+					qflprn(listFlag), qflprn(templateFlag))
+			}
+		}
 		// NOTE: e.g. 'ais cp gs://abc gs:/abc' to sync remote bucket => aistore
 		if bckFrom.Equal(&bckTo) && !bckFrom.IsRemote() {
 			return incorrectUsageMsg(c, errFmtSameBucket, commandCopy, bckTo)
@@ -131,6 +185,11 @@ func copyTransform(c *cli.Context, etlName, objNameOrTmpl string, bckFrom, bckTo
 	}
 
 	// or 2. multi-object x-tco
+	for _, fl := range []cli.Flag{copyCheckpointFlag, copyResumeFlag, copyCompressionFlag} {
+		if flagIsSet(c, fl) {
+			return fmt.Errorf("option %s applies to bucket-to-bucket copy only", qflprn(fl))
+		}
+	}
 	if listObjs == "" && tmplObjs == "" {
 		listObjs = objName // NOTE: "pure" prefix comment in parseObjListTemplate (above)
 	}
@@ -144,17 +203,103 @@ func copyTransform(c *cli.Context, etlName, objNameOrTmpl string, bckFrom, bckTo
 		dryRunCptn(c) // TODO: ditto
 		actionDone(c, prompt)
 	}
+	var naming apc.CopyBckMsg
+	if err := parseCopyNaming(c, &naming); err != nil {
+		return err
+	}
+	if err := checkCopyNaming(c, &naming, listObjs, tmplObjs); err != nil {
+		return err
+	}
 	return runTCO(c, bckFrom, bckTo, listObjs, tmplObjs, etlName)
 }
 
+// destination naming: '--prepend' and '--prefix-replace'
+func parseCopyNaming(c *cli.Context, msg *apc.CopyBckMsg) error {
+	msg.Prepend = parseStrFlag(c, copyPrependFlag)
+	if !flagIsSet(c, copyPrefixReplaceFlag) {
+		return nil
+	}
+	val := parseStrFlag(c, copyPrefixReplaceFlag)
+	parts := strings.Split(val, ",")
+	if len(parts) != 2 || parts[0] == "" {
+		return fmt.Errorf("invalid %s %q: expecting 'OLD,NEW' with non-empty OLD prefix (e.g., 'old/,new/')",
+			qflprn(copyPrefixReplaceFlag), val)
+	}
+	msg.ReplPrefix, msg.ReplPrefixTo = parts[0], parts[1]
+	return nil
+}
+
+// validate destination names of the listed (or templated) objects and, in dry-run mode,
+// show a few examples
+func checkCopyNaming(c *cli.Context, msg *apc.CopyBckMsg, listObjs, tmplObjs string) error {
+	const maxExamples = 3
+	if msg.Prepend == "" && msg.ReplPrefix == "" {
+		return nil
+	}
+	var (
+		names []string
+		tcb   = apc.TCBMsg{CopyBckMsg: *msg}
+		dry   = flagIsSet(c, copyDryRunFlag)
+	)
+	if listObjs != "" {
+		names = splitCsv(listObjs)
+	} else if pt, err := cos.NewParsedTemplate(tmplObjs); err == nil && len(pt.Ranges) > 0 {
+		pt.InitIter()
+		for name, hasNext := pt.Next(); hasNext && len(names) < maxExamples; name, hasNext = pt.Next() {
+			names = append(names, name)
+		}
+	}
+	for i, name := range names {
+		to := tcb.ToName(name)
+		if to == "" {
+			return fmt.Errorf("destination name for %q is empty (check %s and %s)", name,
+				qflprn(copyPrependFlag), qflprn(copyPrefixReplaceFlag))
+		}
+		if dry && i < maxExamples {
+			fmt.Fprintf(c.App.Writer, "\t%s => %s\n", name, to)
+		}
+	}
+	return nil
+}
+
 func _iniCopyBckMsg(c *cli.Context, msg *apc.CopyBckMsg) (err error) {
+	if err := parseCopyNaming(c, msg); err != nil {
+		return err
+	}
 	{
-		msg.Prepend = parseStrFlag(c, copyPrependFlag)
 		msg.Prefix = parseStrFlag(c, verbObjPrefixFlag)
 		msg.DryRun = flagIsSet(c, copyDryRunFlag)
 		msg.Force = flagIsSet(c, forceFlag)
 		msg.LatestVer = flagIsSet(c, latestVerFlag)
 		msg.Sync = flagIsSet(c, syncFlag)
+		msg.PreCount = flagIsSet(c, copyPreCountFlag)
+		msg.Regex = parseStrFlag(c, copyRegexFlag)
+		msg.CustomFilter = parseStrFlag(c, copyFilterCustomFlag)
+		msg.Checkpoint = flagIsSet(c, copyCheckpointFlag)
+		msg.Resume = parseStrFlag(c, copyResumeFlag)
+		msg.CompressionLevel = parseStrFlag(c, copyCompressionFlag)
+		msg.Transcode = parseStrFlag(c, copyTranscodeFlag)
+	}
+	if !apc.IsValidTranscode(msg.Transcode) {
+		return fmt.Errorf("invalid %s %q (expecting one of: %v)", qflprn(copyTranscodeFlag), msg.Transcode,
+			apc.SupportedTranscode)
+	}
+	if msg.Transcode != "" && msg.Sync {
+		return fmt.Errorf(errFmtExclusive, qflprn(copyTranscodeFlag), qflprn(syncFlag))
+	}
+	if msg.CustomFilter != "" {
+		if _, _, err := msg.ParseCustomFilter(); err != nil {
+			return fmt.Errorf("%s: %v", qflprn(copyFilterCustomFlag), err)
+		}
+	}
+	if !apc.IsValidCompressionLevel(msg.CompressionLevel) {
+		return fmt.Errorf("invalid %s %q (expecting one of: %v)", qflprn(copyCompressionFlag), msg.CompressionLevel,
+			apc.SupportedCompressionLevel)
+	}
+	if flagIsSet(c, copyMaxBWFlag) {
+		if msg.MaxBW, err = parseSizeFlag(c, copyMaxBWFlag); err != nil {
+			return err
+		}
 	}
 	if msg.Sync && msg.Prepend != "" {
 		err = fmt.Errorf("prepend option (%q) is incompatible with %s (the latter requires identical source/destination naming)",
@@ -206,7 +351,8 @@ func copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck, allIncludingRemote bool)
 		}
 	}
 
-	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+	dryRun := flagIsSet(c, copyDryRunFlag) // always wait to report dry-run counts
+	if !dryRun && !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
 		/// TODO: unify vs e2e: ("%s[%s] %s => %s", kind, xid, from, to)
 		if flagIsSet(c, nonverboseFlag) {
 			fmt.Fprintln(c.App.Writer, xid)
@@ -223,11 +369,77 @@ func copyBucket(c *cli.Context, bckFrom, bckTo cmn.Bck, allIncludingRemote bool)
 	}
 	fmt.Fprintf(c.App.Writer, tcbtcoCptn("Copying", bckFrom, bckTo)+" ...")
 	xargs := xact.ArgsMsg{ID: xid, Kind: kind, Timeout: timeout}
-	if err := waitXact(&xargs); err != nil {
+	if err := waitCopyXact(c, &xargs); err != nil {
 		fmt.Fprintf(c.App.ErrWriter, fmtXactFailed, "copy", from, to)
 		return err
 	}
 	actionDone(c, fmtXactSucceeded)
+	if dryRun {
+		return dryRunCopyCounts(c, &xargs)
+	}
+	return nil
+}
+
+// wait for x-tcb or x-tco to finish; when the (non-zero) timeout expires, fail with
+// a clear error, and abort the job if requested
+func waitCopyXact(c *cli.Context, xargs *xact.ArgsMsg) error {
+	err := waitXact(xargs)
+	if err == nil || !errors.Is(err, api.ErrWaitTimeout) {
+		return err
+	}
+	return copyTimedOut(c, xargs.ID, xargs.Kind, xargs.Timeout)
+}
+
+// (kindOrName: xaction kind or display name)
+func copyTimedOut(c *cli.Context, xid, kindOrName string, timeout time.Duration) error {
+	kind, xname := xact.GetKindName(kindOrName)
+	cname := xact.Cname(xname, xid)
+	if !flagIsSet(c, copyAbortOnTimeoutFlag) {
+		return fmt.Errorf("timed out (%v) waiting for %s to finish; the job is still running (see 'ais show job %s', %s)",
+			timeout, cname, xid, qflprn(copyAbortOnTimeoutFlag))
+	}
+	if err := api.AbortXaction(apiBP, &xact.ArgsMsg{ID: xid, Kind: kind}); err != nil {
+		return fmt.Errorf("timed out (%v) waiting for %s to finish; failed to abort the job: %v", timeout, cname, V(err))
+	}
+	return fmt.Errorf("timed out (%v) waiting for %s to finish - aborted", timeout, cname)
+}
+
+// [DRY-RUN] sum up (objects, bytes) that would be copied across all targets;
+// x-tcb reports those via extended stats (see xs.ExtTCBStats), otherwise (e.g., x-tco)
+// falling back to generic object counters
+func dryRunCopyCounts(c *cli.Context, xargs *xact.ArgsMsg) error {
+	snaps, err := api.QueryXactionSnaps(apiBP, xargs)
+	if err != nil {
+		return V(err)
+	}
+	var (
+		num, size int64
+		found     bool
+	)
+	for _, tsnaps := range snaps {
+		for _, snap := range tsnaps {
+			if snap.ID != xargs.ID {
+				continue
+			}
+			extStats, ok := snap.Ext.(map[string]any)
+			if !ok || extStats["tcb.dry-run.n"] == nil {
+				continue
+			}
+			found = true
+			n, _ := strconv.ParseInt(fmt.Sprintf("%v", extStats["tcb.dry-run.n"]), 10, 64)
+			num += n
+			if v := extStats["tcb.dry-run.size"]; v != nil {
+				s, _ := strconv.ParseInt(fmt.Sprintf("%v", v), 10, 64)
+				size += s
+			}
+		}
+	}
+	if !found {
+		num, _, _ = snaps.ObjCounts(xargs.ID)
+		size, _, _ = snaps.ByteCounts(xargs.ID)
+	}
+	dryRunCptn(c)
+	fmt.Fprintf(c.App.Writer, "would copy %d object%s (%s)\n", num, cos.Plural(int(num)), cos.ToSizeIEC(size, 2))
 	return nil
 }
 
@@ -262,6 +474,13 @@ func etlBucket(c *cli.Context, etlName string, bckFrom, bckTo cmn.Bck, allInclud
 	if err := _iniCopyBckMsg(c, &msg.CopyBckMsg); err != nil {
 		return err
 	}
+	if flagIsSet(c, etlNumWorkersFlag) {
+		n := parseIntFlag(c, etlNumWorkersFlag)
+		if n < 0 || n > apc.MaxTCBParallelism {
+			return fmt.Errorf("invalid %s=%d: expecting (0..%d) range", flprn(etlNumWorkersFlag), n, apc.MaxTCBParallelism)
+		}
+		msg.Transform.Parallelism = n
+	}
 	if flagIsSet(c, etlExtFlag) {
 		mapStr := parseStrFlag(c, etlExtFlag)
 		extMap := make(cos.StrKVs, 1)
@@ -19,6 +19,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/NVIDIA/aistore/api"
@@ -491,8 +492,14 @@ func bucketsFromArgsOrEnv(c *cli.Context) ([]cmn.Bck, error) {
 //
 // 3. On the client side, we currently resort to an intuitive convention
 // that all non-modifying operations (LIST, GET, HEAD) utilize `dontAddBckMD = true`.
+//
+// 4. Successful results are cached for the duration of a given CLI command (see `bpCache`).
 func headBucket(bck cmn.Bck, dontAddBckMD bool) (p *cmn.Bprops, err error) {
+	if p = bpCache.get(bck, dontAddBckMD); p != nil {
+		return p, nil
+	}
 	if p, err = api.HeadBucket(apiBP, bck, dontAddBckMD); err == nil {
+		bpCache.put(bck, p, !dontAddBckMD)
 		return
 	}
 	if herr, ok := err.(*cmn.ErrHTTP); ok {
@@ -516,6 +523,50 @@ func headBucket(bck cmn.Bck, dontAddBckMD bool) (p *cmn.Bprops, err error) {
 	return
 }
 
+// per-invocation cache of bucket props to avoid redundant HEAD(bucket) requests
+// in bulk operations; must be invalidated when a command creates, destroys,
+// renames, evicts, or otherwise modifies the bucket
+
+type (
+	bpEntry struct {
+		props *cmn.Bprops
+		added bool // true when HEAD-ed with dontAddBckMD = false
+	}
+	bpCacheT struct {
+		m  map[string]bpEntry
+		mu sync.Mutex
+	}
+)
+
+var bpCache bpCacheT
+
+func (bc *bpCacheT) get(bck cmn.Bck, dontAddBckMD bool) *cmn.Bprops {
+	bc.mu.Lock()
+	e, ok := bc.m[bck.MakeUname("")]
+	bc.mu.Unlock()
+	if !ok || (!e.added && !dontAddBckMD) {
+		return nil
+	}
+	return e.props.Clone()
+}
+
+func (bc *bpCacheT) put(bck cmn.Bck, props *cmn.Bprops, added bool) {
+	bc.mu.Lock()
+	if bc.m == nil {
+		bc.m = make(map[string]bpEntry, 4)
+	}
+	bc.m[bck.MakeUname("")] = bpEntry{props: props.Clone(), added: added}
+	bc.mu.Unlock()
+}
+
+func (bc *bpCacheT) del(bcks ...cmn.Bck) {
+	bc.mu.Lock()
+	for i := range bcks {
+		delete(bc.m, bcks[i].MakeUname(""))
+	}
+	bc.mu.Unlock()
+}
+
 // Prints multiple lines of fmtStr to writer w.
 // For line number i, fmtStr is formatted with values of args at index i
 // - if maxLines >= 0 prints at most maxLines
@@ -643,7 +694,7 @@ func confirm(c *cli.Context, prompt string, warning ...string) (ok bool) {
 	for {
 		response := strings.ToLower(readValue(c, prompt))
 		if ok, err = cos.ParseBool(response); err != nil {
-			fmt.Println("Invalid input! Choose 'Y' for 'Yes' or 'N' for 'No'")
+			fmt.Fprintln(c.App.Writer, "Invalid input! Choose 'Y' for 'Yes' or 'N' for 'No'")
 			continue
 		}
 		return
@@ -6,10 +6,12 @@
 package cli
 
 import (
-	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"net"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
@@ -23,6 +25,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
 	"github.com/urfave/cli"
 	"github.com/vbauerster/mpb/v4"
 	"github.com/vbauerster/mpb/v4/decor"
@@ -39,6 +42,8 @@ type (
 		cptn      string
 		totalSize int64
 		dryRun    bool
+		storeMD5  bool          // putStoreMD5Flag
+		ttl       time.Duration // putTTLFlag
 	}
 	uctx struct {
 		wg            cos.WG
@@ -54,6 +59,12 @@ type (
 		mx            sync.Mutex
 		verbose       bool
 		showProgress  bool
+		// multi-object GET
+		ctx       context.Context // canceled upon the first failure (unless contOnErr)
+		cancel    context.CancelFunc
+		bp        *api.BaseParams // apiBP with a cancelable client
+		canceled  atomic.Int32    // not started (or interrupted) due to a prior failure
+		contOnErr bool
 	}
 )
 
@@ -62,10 +73,29 @@ func verbFobjs(c *cli.Context, wop wop, fobjs []fobj, bck cmn.Bck, ndir int, rec
 	if l == 0 {
 		return fmt.Errorf("no files to %s (check source name and formatting, see examples)", wop.verb())
 	}
+	if flagIsSet(c, flatFlag) {
+		if err := checkFlatNames(fobjs); err != nil {
+			return err
+		}
+	}
+	var nskip int
+	if flagIsSet(c, skipExistingFlag) {
+		var err error
+		if fobjs, nskip, err = skipExisting(c, bck, fobjs); err != nil {
+			return err
+		}
+		if l = len(fobjs); l == 0 {
+			actionDone(c, fmt.Sprintf("All %d file%s already exist in %s - nothing to do", nskip, cos.Plural(nskip), bck.Cname("")))
+			return nil
+		}
+	}
 
 	var cptn string
 	cptn += fmt.Sprintf("%s %d file%s", wop.verb(), l, cos.Plural(l))
 	cptn += ndir2tag(ndir, recurs)
+	if nskip > 0 {
+		cptn += fmt.Sprintf(" (skipping %d existing)", nskip)
+	}
 	var arrowCaptionBuilder strings.Builder
 	arrowCaptionBuilder.WriteString(cptn)
 	arrowCaptionBuilder.WriteString(" => ")
@@ -112,6 +142,8 @@ func verbFobjs(c *cli.Context, wop wop, fobjs []fobj, bck cmn.Bck, ndir int, rec
 		cptn:      cptn,
 		totalSize: totalSize,
 		dryRun:    flagIsSet(c, dryRunFlag),
+		storeMD5:  flagIsSet(c, putStoreMD5Flag),
+		ttl:       putTTL(c),
 	}
 	return uparams.do(c)
 }
@@ -133,6 +165,65 @@ func ndir2tag(ndir int, recurs bool) (tag string) {
 	return
 }
 
+// '--skip-existing': list destination objects (once, by the longest common prefix)
+// and filter out files that are already there (same name and size and, optionally, checksum)
+func skipExisting(c *cli.Context, bck cmn.Bck, fobjs []fobj) ([]fobj, int, error) {
+	var (
+		cksumType string
+		prefix    = fobjs[0].dstName
+	)
+	for _, fo := range fobjs[1:] {
+		for !strings.HasPrefix(fo.dstName, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	msg := &apc.LsoMsg{Prefix: prefix}
+	msg.AddProps(apc.GetPropsSize)
+	if flagIsSet(c, putObjDfltCksumFlag) {
+		bckProps, err := headBucket(bck, false /* don't add */)
+		if err != nil {
+			return nil, 0, err
+		}
+		if cksumType = bckProps.Cksum.Type; cksumType != cos.ChecksumNone {
+			msg.AddProps(apc.GetPropsChecksum)
+		}
+	}
+	lst, err := api.ListObjects(apiBP, bck, msg, api.ListArgs{})
+	if err != nil {
+		return nil, 0, V(err)
+	}
+	existing := make(map[string]*cmn.LsoEntry, len(lst.Entries))
+	for _, en := range lst.Entries {
+		existing[en.Name] = en
+	}
+
+	out := fobjs[:0]
+	for _, fo := range fobjs {
+		en, ok := existing[fo.dstName]
+		if !ok || en.Size != fo.size || !_sameCksum(fo, en, cksumType) {
+			out = append(out, fo)
+		}
+	}
+	return out, len(fobjs) - len(out), nil
+}
+
+func _sameCksum(fo fobj, en *cmn.LsoEntry, cksumType string) bool {
+	if cksumType == "" || cksumType == cos.ChecksumNone || en.Checksum == "" {
+		return true
+	}
+	fh, err := os.Open(fo.path)
+	if err != nil {
+		return false
+	}
+	defer fh.Close()
+	ckh := cos.NewCksumHash(cksumType)
+	if _, err := io.Copy(ckh.H, fh); err != nil {
+		return false
+	}
+	ckh.Finalize()
+	return ckh.Value() == en.Checksum
+}
+
 /////////////
 // uparams //
 /////////////
@@ -201,6 +292,8 @@ func (p *uparams) _putOne(c *cli.Context, fobj fobj, reader cos.ReadOpenCloser,
 		Cksum:      p.cksum,
 		Size:       uint64(fobj.size),
 		SkipVC:     skipVC,
+		StoreMD5:   p.storeMD5,
+		CustomMD:   ttlMD(p.ttl),
 	}
 	_, err = api.PutObject(&putArgs)
 	return
@@ -225,7 +318,7 @@ func (p *uparams) _a2aOne(c *cli.Context, fobj fobj, reader cos.ReadOpenCloser,
 		Bck:        p.bck,
 		ObjName:    a.dst.oname, // SHARD_NAME (compare w/ append-prefix for put)
 		Reader:     reader,
-		Cksum:      nil,
+		Cksum:      p.cksum,
 		Size:       uint64(fobj.size),
 		SkipVC:     skipVC,
 	}
@@ -233,13 +326,7 @@ func (p *uparams) _a2aOne(c *cli.Context, fobj fobj, reader cos.ReadOpenCloser,
 	putApndArchArgs := api.PutApndArchArgs{
 		PutArgs:  putArgs,
 		ArchPath: archpath,
-	}
-	if a.appendOnly {
-		putApndArchArgs.Flags = apc.ArchAppend
-	}
-	if a.appendOrPut {
-		debug.Assert(!a.appendOnly)
-		putApndArchArgs.Flags = apc.ArchAppendIfExist
+		Flags:    a.flags(),
 	}
 	return api.PutApndArch(&putApndArchArgs)
 }
@@ -298,13 +385,18 @@ func (u *uctx) do(c *cli.Context, p *uparams, fobj fobj, fh *cos.FileHandle, upd
 	var (
 		err         error
 		skipVC      = flagIsSet(c, skipVerCksumFlag)
-		countReader = cos.NewCallbackReadOpenCloser(fh, updateBar /*progress callback*/)
+		countReader = newRetryReader(fh, updateBar /*progress callback*/)
+		what        = p.bck.Cname(fobj.dstName)
 	)
 	switch p.wop.verb() {
 	case "PUT":
-		err = p._putOne(c, fobj, countReader, skipVC)
+		err = withRetry(c, p.wop.verb(), what, countReader, func(r cos.ReadOpenCloser) error {
+			return p._putOne(c, fobj, r, skipVC)
+		}, u.retryNote)
 	case "APPEND":
-		err = p._a2aOne(c, fobj, countReader, skipVC)
+		err = withRetry(c, p.wop.verb(), what, countReader, func(r cos.ReadOpenCloser) error {
+			return p._a2aOne(c, fobj, r, skipVC)
+		}, u.retryNote)
 	default:
 		debug.Assert(false, p.wop.verb()) // "ARCHIVE"
 		actionWarn(c, fmt.Sprintf("%q not implemented yet", p.wop.verb()))
@@ -323,6 +415,20 @@ func (u *uctx) do(c *cli.Context, p *uparams, fobj fobj, fh *cos.FileHandle, upd
 	}
 }
 
+// (verbose) retry notification - not to interfere with progress bars
+func (u *uctx) retryNote(c *cli.Context, msg string) {
+	if !u.verbose {
+		return
+	}
+	if u.showProgress {
+		u.mx.Lock()
+		u.errSb.WriteString(msg + "\n")
+		u.mx.Unlock()
+	} else {
+		fmt.Fprintln(c.App.Writer, msg)
+	}
+}
+
 func (u *uctx) fini(c *cli.Context, p *uparams, f fobj) {
 	var (
 		total = int(u.processedCnt.Inc())
@@ -349,6 +455,80 @@ func (u *uctx) fini(c *cli.Context, p *uparams, f fobj) {
 	u.mx.Unlock()
 }
 
+// '--append-if-exists': append the file to the destination object if the latter exists (in-cluster),
+// or PUT a new one otherwise; returns true if appended
+// NOTE: HEAD followed by APPEND (or PUT) is not atomic - concurrent writers race and the last one wins
+func putAppendIfExists(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.FileInfo) (bool, error) {
+	exists, err := objExists(bck, objName)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, putRegular(c, bck, objName, path, finfo)
+	}
+	fh, err := cos.NewFileHandle(path)
+	if err != nil {
+		return false, err
+	}
+	handle, err := api.AppendObject(&api.AppendArgs{
+		BaseParams: apiBP,
+		Bck:        bck,
+		Object:     objName,
+		Reader:     fh,
+		Size:       finfo.Size(),
+	})
+	if err != nil {
+		return false, V(err)
+	}
+	err = api.FlushObject(&api.FlushArgs{
+		BaseParams: apiBP,
+		Bck:        bck,
+		Object:     objName,
+		Handle:     handle,
+	})
+	return true, V(err)
+}
+
+func objExists(bck cmn.Bck, objName string) (bool, error) {
+	_, err := api.HeadObject(apiBP, bck, objName, apc.FltPresentNoProps, true /*silent*/)
+	if err == nil {
+		return true, nil
+	}
+	if cmn.IsStatusNotFound(err) {
+		return false, nil
+	}
+	return false, V(err)
+}
+
+// '--ttl' (validated by putHandler)
+func putTTL(c *cli.Context) time.Duration {
+	if !flagIsSet(c, putTTLFlag) {
+		return 0
+	}
+	return parseDurationFlag(c, putTTLFlag)
+}
+
+// expiration time (now + ttl) as custom metadata (cmn.ExpiresAtObjMD) to PUT with the object
+func ttlMD(ttl time.Duration) cos.StrKVs {
+	if ttl <= 0 {
+		return nil
+	}
+	return cos.StrKVs{cmn.ExpiresAtObjMD: time.Now().Add(ttl).UTC().Format(time.RFC3339)}
+}
+
+// same as above for objects written in multiple requests (multipart upload, appended chunks):
+// if the TTL cannot be stored, remove the newly written object rather than leave it without expiration
+func storeTTL(bck cmn.Bck, objName string, ttl time.Duration) error {
+	err := api.SetObjectCustomProps(apiBP, bck, objName, ttlMD(ttl), false /*set new*/)
+	if err == nil {
+		return nil
+	}
+	if errD := api.DeleteObject(apiBP, bck, objName); errD != nil {
+		return fmt.Errorf("failed to store TTL of %s: %v (and failed to remove it: %v)", bck.Cname(objName), err, errD)
+	}
+	return fmt.Errorf("failed to store TTL of %s (removed): %v", bck.Cname(objName), err)
+}
+
 func putRegular(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.FileInfo) error {
 	var (
 		reader   cos.ReadOpenCloser
@@ -360,69 +540,235 @@ func putRegular(c *cli.Context, bck cmn.Bck, objName, path string, finfo os.File
 		// resulting message printed upon return
 		return nil
 	}
+	if flagIsSet(c, chunkSizeFlag) {
+		chunkSize, err := parseSizeFlag(c, chunkSizeFlag)
+		if err != nil {
+			return err
+		}
+		if chunkSize <= 0 {
+			return fmt.Errorf("invalid %s: chunk size must be positive", qflprn(chunkSizeFlag))
+		}
+		// otherwise, falling back to a single PUT
+		if finfo.Size() > chunkSize {
+			if err := putMultipart(c, bck, objName, path, finfo.Size(), chunkSize); err != nil {
+				return err
+			}
+			if ttl := putTTL(c); ttl > 0 {
+				return storeTTL(bck, objName, ttl)
+			}
+			return nil
+		}
+	} else if flagIsSet(c, putNumWorkersFlag) {
+		return fmt.Errorf("option %s requires %s (multipart upload)", qflprn(putNumWorkersFlag), qflprn(chunkSizeFlag))
+	}
 	cksum, err := cksumToCompute(c, bck)
 	if err != nil {
 		return err
 	}
-	fh, err := cos.NewFileHandle(path)
-	if err != nil {
+	if reader, err = openPutSrc(c, path); err != nil {
 		return err
 	}
-	reader = fh
 	if flagIsSet(c, progressFlag) {
 		// setup progress bar
 		args := barArgs{barType: sizeArg, barText: objName, total: finfo.Size()}
 		progress, bars = simpleBar(args)
 		cb := func(n int, _ error) { bars[0].IncrBy(n) }
-		reader = cos.NewCallbackReadOpenCloser(fh, cb)
+		reader = newRetryReader(reader, cb)
+	}
+
+	err = withRetry(c, "PUT", bck.Cname(objName), reader, func(r cos.ReadOpenCloser) error {
+		putArgs := api.PutArgs{
+			BaseParams: apiBP,
+			Bck:        bck,
+			ObjName:    objName,
+			Reader:     r,
+			Cksum:      cksum,
+			SkipVC:     flagIsSet(c, skipVerCksumFlag),
+			StoreMD5:   flagIsSet(c, putStoreMD5Flag),
+			CustomMD:   ttlMD(putTTL(c)),
+		}
+		_, errP := api.PutObject(&putArgs)
+		return errP
+	}, nil)
+	if progress != nil {
+		progress.Wait()
 	}
+	return err
+}
 
-	putArgs := api.PutArgs{
-		BaseParams: apiBP,
-		Bck:        bck,
-		ObjName:    objName,
-		Reader:     reader,
-		Cksum:      cksum,
-		SkipVC:     flagIsSet(c, skipVerCksumFlag),
+// '--mmap' or regular file handle
+func openPutSrc(c *cli.Context, path string) (cos.ReadOpenCloser, error) {
+	if flagIsSet(c, putMmapFlag) {
+		fh, err := cos.NewMmapFileHandle(path)
+		if err == nil {
+			return fh, nil
+		}
+		if flagIsSet(c, verboseFlag) {
+			actionWarn(c, fmt.Sprintf("%v - falling back to regular reads", err))
+		}
 	}
-	_, err = api.PutObject(&putArgs)
+	return cos.NewFileHandle(path)
+}
+
+// PUT a large file via multipart upload: split it into `--chunk-size` parts, upload the parts
+// in parallel (`--num-workers`), and complete the upload - or abort it upon the first failure
+func putMultipart(c *cli.Context, bck cmn.Bck, objName, path string, size, chunkSize int64) error {
+	nparts := (size + chunkSize - 1) / chunkSize
+	if nparts > maxPutMptParts {
+		return fmt.Errorf("cannot upload %q in %d parts (max %d) - use larger %s", path, nparts, maxPutMptParts,
+			qflprn(chunkSizeFlag))
+	}
+	numWorkers := dfltPutMptWorkers
+	if flagIsSet(c, putNumWorkersFlag) {
+		numWorkers = parseIntFlag(c, putNumWorkersFlag)
+		if numWorkers <= 0 || numWorkers > maxPutMptWorkers {
+			return fmt.Errorf("invalid %s=%d: expecting (1..%d) range", flprn(putNumWorkersFlag), numWorkers, maxPutMptWorkers)
+		}
+	}
+	if cksums := altCksumToComp(c); len(cksums) > 0 || flagIsSet(c, putObjDfltCksumFlag) || flagIsSet(c, putObjCksumTypeFlag) {
+		actionWarn(c, "client-side checksum is not supported with multipart upload - ignoring")
+	}
+
+	uploadID, err := api.CreateMptUploadS3(apiBP, bck, objName)
+	if err != nil {
+		return err
+	}
+
+	var (
+		progress  *mpb.Progress
+		bars      []*mpb.Bar
+		updateBar func(int, error)
+		parts     = make([]*api.MptPartS3, nparts)
+		wg        = cos.NewLimitedWaitGroup(numWorkers, 0)
+		errCount  atomic.Int32
+		firstErr  error
+	)
+	if flagIsSet(c, progressFlag) {
+		args := barArgs{barType: sizeArg, barText: objName, total: size}
+		progress, bars = simpleBar(args)
+		updateBar = func(n int, _ error) { bars[0].IncrBy(n) }
+	}
+	for i := range nparts {
+		var (
+			offset = i * chunkSize
+			n      = min(chunkSize, size-offset)
+			partNo = int(i + 1)
+		)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if errCount.Load() > 0 {
+				return
+			}
+			etag, errP := putMptPart(c, bck, objName, path, uploadID, partNo, offset, n, updateBar)
+			if errP != nil {
+				if errCount.Inc() == 1 {
+					firstErr = errP // (read upon wg.Wait)
+				}
+				return
+			}
+			parts[partNo-1] = &api.MptPartS3{ETag: etag, PartNumber: int32(partNo)}
+		}()
+	}
+	wg.Wait()
 	if progress != nil {
+		if firstErr != nil {
+			bars[0].Abort(true)
+		}
 		progress.Wait()
 	}
-	return err
+
+	if firstErr == nil {
+		firstErr = api.CompleteMptUploadS3(apiBP, bck, objName, uploadID, parts)
+		if firstErr == nil {
+			return nil
+		}
+	}
+	if errA := api.AbortMptUploadS3(apiBP, bck, objName, uploadID); errA != nil {
+		actionWarn(c, fmt.Sprintf("failed to abort multipart upload %q: %v", uploadID, errA))
+	}
+	return fmt.Errorf("multipart upload %s => %s failed: %v", path, bck.Cname(objName), firstErr)
+}
+
+func putMptPart(c *cli.Context, bck cmn.Bck, objName, path, uploadID string, partNo int, offset, size int64,
+	updateBar func(int, error)) (etag string, err error) {
+	fsh, err := cos.NewFileSectionHandle(path, offset, size)
+	if err != nil {
+		return "", err
+	}
+	var reader cos.ReadOpenCloser = fsh
+	if updateBar != nil {
+		reader = newRetryReader(fsh, updateBar)
+	}
+	what := fmt.Sprintf("%s (part %d)", bck.Cname(objName), partNo)
+	err = withRetry(c, "PUT", what, reader, func(r cos.ReadOpenCloser) error {
+		putArgs := api.PutArgs{
+			BaseParams: apiBP,
+			Bck:        bck,
+			ObjName:    objName,
+			Reader:     r,
+			Size:       uint64(size),
+		}
+		var errP error
+		etag, errP = api.PutMptPartS3(&putArgs, uploadID, partNo)
+		return errP
+	}, nil)
+	return etag, err
+}
+
+// read up to `chunkSize` bytes into (reset) sgl, updating the checksum if requested
+func readChunk(sgl *memsys.SGL, r io.Reader, cksum *cos.CksumHash, chunkSize int64, buf []byte) (n int64, err error) {
+	var w io.Writer = sgl
+	sgl.Reset()
+	if cksum != nil {
+		w = cos.NewWriterMulti(cksum.H, sgl)
+	}
+	n, err = io.CopyBuffer(w, io.LimitReader(r, chunkSize), buf)
+	if err == io.EOF {
+		err = nil
+	}
+	return n, err
 }
 
 // PUT and then APPEND fixed-sized chunks using `api.PutObject`, `api.AppendObject` and `api.FlushObject`
-// - currently, is only used to PUT from standard input when we do expect to overwrite existing destination object
-// - APPEND and flush will only be executed with there's a second chunk
-func putAppendChunks(c *cli.Context, bck cmn.Bck, objName string, r io.Reader, cksumType string, chunkSize int64) error {
+// - currently, is only used to write from standard input
+// - PUT (the default) overwrites existing destination object, APPEND and flush will only be executed
+// when there's a second chunk
+// - with `apnd` all chunks are appended - to the existing object, if exists (and, if doesn't, to a new one)
+func putAppendChunks(c *cli.Context, bck cmn.Bck, objName string, r io.Reader, cksumType string, chunkSize int64, apnd bool) error {
 	var (
 		handle string
 		cksum  = cos.NewCksumHash(cksumType)
 		pi     = newProgIndicator(objName)
 	)
+	mm, err := memsys.NewMMSA("cli-put-chunks", true /*silent*/)
+	if err != nil {
+		debug.AssertNoErr(err) // unlikely
+		return err
+	}
+	// one SGL (and one copy buffer) reused across all chunks
+	sgl := mm.NewSGL(chunkSize)
+	defer sgl.Free()
+	buf, slab := mm.AllocSize(memsys.DefaultBufSize)
+	defer slab.Free(buf)
+	ckh := cksum
+	if cksumType == cos.ChecksumNone {
+		ckh = nil
+	}
+
 	if flagIsSet(c, progressFlag) {
 		pi.start()
 	}
 	for i := 0; ; i++ {
-		var (
-			b      = bytes.NewBuffer(nil)
-			n      int64
-			err    error
-			reader cos.ReadOpenCloser
-		)
-		if cksumType != cos.ChecksumNone {
-			n, err = io.CopyN(cos.NewWriterMulti(cksum.H, b), r, chunkSize)
-		} else {
-			n, err = io.CopyN(b, r, chunkSize)
-		}
-		if err != nil && err != io.EOF {
+		var reader cos.ReadOpenCloser
+		n, err := readChunk(sgl, r, ckh, chunkSize, buf)
+		if err != nil {
 			return err
 		}
 		if n == 0 {
 			break
 		}
-		reader = cos.NewByteHandle(b.Bytes())
+		reader = memsys.NewReader(sgl)
 		if flagIsSet(c, progressFlag) {
 			actualChunkOffset := atomic.NewInt64(0)
 			reader = cos.NewCallbackReadOpenCloser(reader, func(n int, _ error) {
@@ -440,7 +786,7 @@ func putAppendChunks(c *cli.Context, bck cmn.Bck, objName string, r io.Reader, c
 				pi.printProgress(int64(n))
 			})
 		}
-		if i == 0 {
+		if i == 0 && !apnd {
 			// overwrite, if exists
 			// NOTE: when followed by APPEND (below) will increment resulting ais object's version one extra time
 			putArgs := api.PutArgs{
@@ -484,6 +830,87 @@ func putAppendChunks(c *cli.Context, bck cmn.Bck, objName string, r io.Reader, c
 	})
 }
 
+//
+// PUT retries
+//
+
+const maxPutRetryBackoff = time.Minute
+
+// Execute `put` and retry upon retriable errors with exponential backoff.
+// - the number of retries and the initial backoff are given by `putRetriesFlag` and `putRetryBackoffFlag`
+// - each retry reopens the reader (note that `api.PutObject` et al. always close the one they are given)
+// - `note` (optional) is called to notify about each retry at verbose level
+func withRetry(c *cli.Context, verb, what string, reader cos.ReadOpenCloser, put func(cos.ReadOpenCloser) error,
+	note func(*cli.Context, string)) (err error) {
+	var (
+		retries = parseIntFlag(c, putRetriesFlag)
+		sleep   = parseDurationFlag(c, putRetryBackoffFlag)
+	)
+	for i := 0; ; i++ {
+		if err = put(reader); err == nil || i >= retries || !isRetriablePut(err) {
+			return err
+		}
+		msg := fmt.Sprintf("%s %s failed (%v) - retrying in %v (%d/%d)", verb, what, err, sleep, i+1, retries)
+		switch {
+		case note != nil:
+			note(c, msg)
+		case flagIsSet(c, verboseFlag):
+			fmt.Fprintln(c.App.Writer, msg)
+		}
+		time.Sleep(sleep)
+		sleep = min(sleep*2, maxPutRetryBackoff)
+
+		if reader, err = reader.Open(); err != nil {
+			return err
+		}
+	}
+}
+
+// timeouts, 5xx, 408, 429, connection refused/reset, broken pipe - but not 4xx (including bad checksum)
+func isRetriablePut(err error) bool {
+	if herr, ok := err.(*cmn.ErrHTTP); ok {
+		return herr.Status >= http.StatusInternalServerError ||
+			herr.Status == http.StatusRequestTimeout || herr.Status == http.StatusTooManyRequests
+	}
+	if cos.IsRetriableConnErr(err) || cos.IsUnreachable(err, 0) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var nerr net.Error
+	return errors.As(err, &nerr) && nerr.Timeout()
+}
+
+// progress-reporting reader that does not count the same bytes twice when reopened (to retry)
+type retryReader struct {
+	roc      cos.ReadOpenCloser
+	cb       func(int, error)
+	reported *int64 // shared by all reopened instances
+	read     int64
+}
+
+func newRetryReader(roc cos.ReadOpenCloser, cb func(int, error)) *retryReader {
+	return &retryReader{roc: roc, cb: cb, reported: new(int64)}
+}
+
+func (r *retryReader) Read(p []byte) (n int, err error) {
+	n, err = r.roc.Read(p)
+	r.read += int64(n)
+	if diff := r.read - *r.reported; diff > 0 {
+		r.cb(int(diff), err)
+		*r.reported = r.read
+	}
+	return n, err
+}
+
+func (r *retryReader) Open() (cos.ReadOpenCloser, error) {
+	roc, err := r.roc.Open()
+	if err != nil {
+		return nil, err
+	}
+	return &retryReader{roc: roc, cb: r.cb, reported: r.reported}, nil
+}
+
+func (r *retryReader) Close() error { return r.roc.Close() }
+
 //
 // PUT checksum
 //
@@ -504,6 +931,24 @@ func initPutObjCksumFlags() (flags []cli.Flag) {
 }
 
 func cksumToCompute(c *cli.Context, bck cmn.Bck) (*cos.Cksum, error) {
+	// explicitly requested type overrides bucket-configured checksum
+	if flagIsSet(c, putObjCksumTypeFlag) {
+		if flagIsSet(c, putObjDfltCksumFlag) {
+			return nil, fmt.Errorf(errFmtExclusive, qflprn(putObjCksumTypeFlag), qflprn(putObjDfltCksumFlag))
+		}
+		if len(altCksumToComp(c)) > 0 {
+			return nil, fmt.Errorf("option %s cannot be used together with explicitly provided checksum value",
+				qflprn(putObjCksumTypeFlag))
+		}
+		ty := parseStrFlag(c, putObjCksumTypeFlag)
+		if err := cos.ValidateCksumType(ty); err != nil {
+			return nil, err
+		}
+		if ty == cos.ChecksumNone {
+			return nil, nil
+		}
+		return cos.NewCksum(ty, ""), nil
+	}
 	// bucket-configured checksum takes precedence
 	if flagIsSet(c, putObjDfltCksumFlag) {
 		bckProps, err := headBucket(bck, false /* don't add */)
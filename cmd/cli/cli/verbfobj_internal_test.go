@@ -0,0 +1,75 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2018-2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+const (
+	benchChunkSize = 8 * cos.MiB
+	benchTotalSize = 64 * cos.MiB
+)
+
+// compare per-chunk allocations: fresh bytes.Buffer vs. reusable SGL (see putAppendChunks)
+func BenchmarkPutChunks(b *testing.B) {
+	src := bytes.Repeat([]byte{'a'}, benchTotalSize)
+
+	b.Run("bytes.Buffer", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(benchTotalSize)
+		for range b.N {
+			var (
+				r     = bytes.NewReader(src)
+				cksum = cos.NewCksumHash(cos.ChecksumXXHash)
+			)
+			for {
+				buf := bytes.NewBuffer(nil)
+				n, err := io.CopyN(cos.NewWriterMulti(cksum.H, buf), r, benchChunkSize)
+				if err != nil && err != io.EOF {
+					b.Fatal(err)
+				}
+				if n == 0 {
+					break
+				}
+			}
+		}
+	})
+
+	b.Run("sgl", func(b *testing.B) {
+		mm, err := memsys.NewMMSA("bench-put-chunks", true)
+		if err != nil {
+			b.Fatal(err)
+		}
+		sgl := mm.NewSGL(benchChunkSize)
+		defer sgl.Free()
+		buf, slab := mm.AllocSize(memsys.DefaultBufSize)
+		defer slab.Free(buf)
+
+		b.ReportAllocs()
+		b.SetBytes(benchTotalSize)
+		b.ResetTimer()
+		for range b.N {
+			var (
+				r     = bytes.NewReader(src)
+				cksum = cos.NewCksumHash(cos.ChecksumXXHash)
+			)
+			for {
+				n, err := readChunk(sgl, r, cksum, benchChunkSize, buf)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if n == 0 {
+					break
+				}
+			}
+		}
+	})
+}
@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -31,8 +33,16 @@ type (
 		dstName string
 		size    int64
 	}
+	// (optional) size and mtime filters - see fileMinSizeFlag, et al.
+	fobjFlt struct {
+		newer   time.Time
+		older   time.Time
+		minSize int64
+		maxSize int64
+	}
 	// recursive walk
 	walkCtx struct {
+		flt        *fobjFlt
 		pattern    string
 		trimPref   string
 		appendPref string
@@ -59,7 +69,7 @@ func rangeTrimPrefix(pt *cos.ParsedTemplate) string {
 
 // Returns files from the 'path' directory. No recursion.
 // If shell filename-matching pattern is present include only those that match.
-func listDir(path, trimPref, appendPref, pattern string) (fobjs fobjs, _ error) {
+func listDir(path, trimPref, appendPref, pattern string, flt *fobjFlt) (fobjs fobjs, _ error) {
 	dentries, err := os.ReadDir(path)
 	if err != nil {
 		return nil, err
@@ -72,6 +82,9 @@ func listDir(path, trimPref, appendPref, pattern string) (fobjs fobjs, _ error)
 			continue
 		}
 		if finfo, err := dent.Info(); err == nil {
+			if !flt.match(finfo) {
+				continue
+			}
 			fullPath := filepath.Join(path, dent.Name())
 			fobj := fobj{
 				dstName: appendPref + trimPrefix(fullPath, trimPref), // empty strings ignored
@@ -86,8 +99,9 @@ func listDir(path, trimPref, appendPref, pattern string) (fobjs fobjs, _ error)
 
 // Traverse 'path' recursively
 // If shell filename-matching pattern is present include only those that match
-func listRecurs(path, trimPref, appendPref, pattern string) (fobjs, error) {
+func listRecurs(path, trimPref, appendPref, pattern string, flt *fobjFlt) (fobjs, error) {
 	ctx := &walkCtx{
+		flt:        flt,
 		pattern:    pattern,
 		trimPref:   trimPref,
 		appendPref: appendPref,
@@ -102,6 +116,7 @@ func listRecurs(path, trimPref, appendPref, pattern string) (fobjs, error) {
 // - source path that may contain wildcard(s)
 // - (trimPref, appendPref) combo to influence destination naming
 // - recursive, etc.
+// - size and mtime filters, if specified
 // Returns:
 // - a slice of matching triplets: {source fname or dirname, destination name, size in bytes}
 func lsFobj(c *cli.Context, path, trimPref, appendPref string, ndir *int, recurs, incl bool) (fobjs, error) {
@@ -109,6 +124,10 @@ func lsFobj(c *cli.Context, path, trimPref, appendPref string, ndir *int, recurs
 		pattern    = "*" // default pattern: entire directory
 		finfo, err = os.Stat(path)
 	)
+	flt, errF := newFobjFlt(c)
+	if errF != nil {
+		return nil, errF
+	}
 	debug.Assert(trimPref == "" || strings.HasPrefix(path, trimPref))
 
 	// single file (uses cases: reg file, --template, --list)
@@ -121,8 +140,15 @@ func lsFobj(c *cli.Context, path, trimPref, appendPref string, ndir *int, recurs
 				trimPref = filepath.Dir(trimPref)
 			}
 		}
+		if !flt.match(finfo) {
+			return nil, nil
+		}
+		dstName := appendPref + trimPrefix(path, trimPref)
+		if flagIsSet(c, flatFlag) {
+			dstName = appendPref + filepath.Base(path)
+		}
 		fo := fobj{
-			dstName: appendPref + trimPrefix(path, trimPref),
+			dstName: dstName,
 			path:    path,
 			size:    finfo.Size(),
 		}
@@ -159,7 +185,26 @@ func lsFobj(c *cli.Context, path, trimPref, appendPref string, ndir *int, recurs
 	if recurs {
 		f = listRecurs
 	}
-	return f(path, trimPref, appendPref, pattern)
+	fobjs, err := f(path, trimPref, appendPref, pattern, flt)
+	if err == nil && flagIsSet(c, flatFlag) {
+		for i := range fobjs {
+			fobjs[i].dstName = appendPref + filepath.Base(fobjs[i].path)
+		}
+	}
+	return fobjs, err
+}
+
+// with '--flat' destination names may collide (checked across all sources)
+func checkFlatNames(fobjs []fobj) error {
+	names := make(map[string]string, len(fobjs))
+	for _, fo := range fobjs {
+		if other, ok := names[fo.dstName]; ok {
+			return fmt.Errorf("%s: both %q and %q map to the same destination name %q",
+				qflprn(flatFlag), other, fo.path, fo.dstName)
+		}
+		names[fo.dstName] = fo.path
+	}
+	return nil
 }
 
 func groupByExt(files []fobj) (int64, map[string]counter) {
@@ -196,6 +241,9 @@ func (w *walkCtx) do(fqn string, info os.FileInfo, err error) error {
 	if matched, _ := filepath.Match(w.pattern, filepath.Base(fqn)); !matched {
 		return nil
 	}
+	if !w.flt.match(info) {
+		return nil
+	}
 	fobj := fobj{
 		dstName: w.appendPref + trimPrefix(fqn, w.trimPref), // empty strings ignored
 		path:    fqn,
@@ -205,6 +253,62 @@ func (w *walkCtx) do(fqn string, info os.FileInfo, err error) error {
 	return nil
 }
 
+/////////////
+// fobjFlt //
+/////////////
+
+// returns nil when none of the filtering flags is set
+func newFobjFlt(c *cli.Context) (*fobjFlt, error) {
+	var (
+		flt fobjFlt
+		err error
+		now = time.Now()
+	)
+	if !flagIsSet(c, fileMinSizeFlag) && !flagIsSet(c, fileMaxSizeFlag) &&
+		!flagIsSet(c, fileNewerThanFlag) && !flagIsSet(c, fileOlderThanFlag) {
+		return nil, nil
+	}
+	if flagIsSet(c, fileMinSizeFlag) {
+		if flt.minSize, err = parseSizeFlag(c, fileMinSizeFlag); err != nil {
+			return nil, err
+		}
+	}
+	if flagIsSet(c, fileMaxSizeFlag) {
+		if flt.maxSize, err = parseSizeFlag(c, fileMaxSizeFlag); err != nil {
+			return nil, err
+		}
+		if flt.maxSize < flt.minSize {
+			return nil, fmt.Errorf("invalid %s=%s: must be greater or equal %s", qflprn(fileMaxSizeFlag),
+				parseStrFlag(c, fileMaxSizeFlag), qflprn(fileMinSizeFlag))
+		}
+	}
+	if flagIsSet(c, fileNewerThanFlag) {
+		flt.newer = now.Add(-parseDurationFlag(c, fileNewerThanFlag))
+	}
+	if flagIsSet(c, fileOlderThanFlag) {
+		flt.older = now.Add(-parseDurationFlag(c, fileOlderThanFlag))
+		if !flt.newer.IsZero() && !flt.older.After(flt.newer) {
+			return nil, fmt.Errorf("%s and %s define an empty time range", qflprn(fileNewerThanFlag), qflprn(fileOlderThanFlag))
+		}
+	}
+	return &flt, nil
+}
+
+func (flt *fobjFlt) match(finfo os.FileInfo) bool {
+	if flt == nil {
+		return true
+	}
+	size := finfo.Size()
+	if size < flt.minSize || (flt.maxSize > 0 && size > flt.maxSize) {
+		return false
+	}
+	mtime := finfo.ModTime()
+	if !flt.newer.IsZero() && mtime.Before(flt.newer) {
+		return false
+	}
+	return flt.older.IsZero() || mtime.Before(flt.older)
+}
+
 ///////////
 // fobjs //
 ///////////
@@ -212,3 +316,81 @@ func (w *walkCtx) do(fqn string, info os.FileInfo, err error) error {
 func (a fobjs) Len() int           { return len(a) }
 func (a fobjs) Less(i, j int) bool { return a[i].path < a[j].path }
 func (a fobjs) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+
+// '--order-by' (concat)
+const (
+	orderByName    = "name"
+	orderByNatural = "natural"
+	orderByMtime   = "mtime"
+	orderBySize    = "size"
+)
+
+func (a fobjs) sortBy(orderBy string) error {
+	switch orderBy {
+	case "", orderByName:
+		sort.Sort(a)
+	case orderByNatural:
+		sort.SliceStable(a, func(i, j int) bool { return natLess(a[i].path, a[j].path) })
+	case orderBySize:
+		sort.SliceStable(a, func(i, j int) bool {
+			return a[i].size < a[j].size || (a[i].size == a[j].size && a[i].path < a[j].path)
+		})
+	case orderByMtime:
+		mtimes := make(map[string]time.Time, len(a))
+		for _, f := range a {
+			finfo, err := os.Stat(f.path)
+			if err != nil {
+				return err
+			}
+			mtimes[f.path] = finfo.ModTime()
+		}
+		sort.SliceStable(a, func(i, j int) bool {
+			ti, tj := mtimes[a[i].path], mtimes[a[j].path]
+			return ti.Before(tj) || (ti.Equal(tj) && a[i].path < a[j].path)
+		})
+	default:
+		return fmt.Errorf("invalid %s %q: expecting one of: %s, %s, %s, %s", flprn(concatOrderByFlag), orderBy,
+			orderByName, orderByNatural, orderByMtime, orderBySize)
+	}
+	return nil
+}
+
+// "natural" ordering: digit sequences compare numerically (part2 < part10);
+// names that differ only in leading zeros (part7 vs part07) fall back to byte-wise order
+func natLess(a, b string) bool {
+	if less, eq := _natLess(a, b); !eq {
+		return less
+	}
+	return a < b
+}
+
+func _natLess(a, b string) (less, eq bool) {
+	for a != "" && b != "" {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			na, nb := digitsLen(a), digitsLen(b)
+			da, db := strings.TrimLeft(a[:na], "0"), strings.TrimLeft(b[:nb], "0")
+			if len(da) != len(db) {
+				return len(da) < len(db), false
+			}
+			if da != db {
+				return da < db, false
+			}
+			a, b = a[na:], b[nb:]
+			continue
+		}
+		if a[0] != b[0] {
+			return a[0] < b[0], false
+		}
+		a, b = a[1:], b[1:]
+	}
+	return len(a) < len(b), len(a) == len(b)
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func digitsLen(s string) (n int) {
+	for n < len(s) && isDigit(s[n]) {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,61 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestNatLess(t *testing.T) {
+	tests := []struct {
+		a, b string
+		less bool
+	}{
+		{"part2", "part10", true},
+		{"part10", "part2", false},
+		{"part10", "part10", false},
+		{"", "a", true},
+		{"a", "", false},
+		{"2", "10", true},
+		{"a1b2", "a1b10", true},
+		{"a1b10", "a2b1", true},
+		{"file", "file1", true},
+		{"file1", "file", false},
+		{"file1.txt", "file1a", true}, // '.' < 'a'
+		{"abc", "abd", true},
+		{"dir/2/x", "dir/10/a", true},
+		{"18446744073709551616", "18446744073709551617", true}, // beyond uint64
+		{"99999999999999999999", "100000000000000000000", true},
+
+		// leading zeros: same number, byte-wise tie-break
+		{"part007", "part8", true},
+		{"part07", "part7", true},
+		{"part7", "part07", false},
+		{"part07b", "part7a", false},
+	}
+	for _, test := range tests {
+		less := natLess(test.a, test.b)
+		tassert.Errorf(t, less == test.less, "natLess(%q, %q): expected %t, got %t", test.a, test.b, test.less, less)
+	}
+}
+
+func TestSortByNatural(t *testing.T) {
+	var (
+		paths = []string{"shard10.tar", "shard2.tar", "shard1.tar", "shard02.tar", "a/shard3.tar", "shard.tar"}
+		exp   = []string{"a/shard3.tar", "shard.tar", "shard1.tar", "shard02.tar", "shard2.tar", "shard10.tar"}
+		a     = make(fobjs, 0, len(paths))
+	)
+	for _, path := range paths {
+		a = append(a, fobj{path: path})
+	}
+	tassert.CheckFatal(t, a.sortBy(orderByNatural))
+	for i := range a {
+		tassert.Errorf(t, a[i].path == exp[i], "position %d: expected %q, got %q", i, exp[i], a[i].path)
+	}
+
+	tassert.Errorf(t, a.sortBy("random") != nil, "expected error on invalid order")
+}
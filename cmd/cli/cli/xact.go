@@ -201,12 +201,35 @@ func flattenXactStats(snap *core.Snap, units string) nvpairList {
 					vv, err := jsonMarshalIndent(mapVal)
 					debug.AssertNoErr(err)
 					value = string(vv)
+				} else if list, ok := v.([]any); ok {
+					// e.g. x-tco: names of the objects that failed to copy
+					names := make([]string, 0, len(list))
+					for _, name := range list {
+						names = append(names, fmt.Sprintf("%v", name))
+					}
+					value = strings.Join(names, ", ")
 				} else {
 					value = fmt.Sprintf("%v", v)
 				}
 			}
 			props = append(props, nvpair{Name: k, Value: value})
 		}
+		// x-tcb with pre-counted source objects
+		if total, processed := extStats["tcb.total.n"], extStats["tcb.processed.n"]; total != nil && processed != nil {
+			t, errT := strconv.ParseInt(fmt.Sprintf("%v", total), 10, 64)
+			p, errP := strconv.ParseInt(fmt.Sprintf("%v", processed), 10, 64)
+			if errT == nil && errP == nil && t > 0 {
+				pct := min(p*100/t, 100)
+				props = append(props, nvpair{Name: "tcb.progress", Value: strconv.FormatInt(pct, 10) + "%"})
+			}
+		}
+		// x-tco: (bounded) list of failed names may be shorter than the total
+		if cnt, names := extStats["tco.failed.n"], extStats["tco.failed.names"]; cnt != nil {
+			n, err := strconv.Atoi(fmt.Sprintf("%v", cnt))
+			if list, ok := names.([]any); ok && err == nil && n > len(list) {
+				props = append(props, nvpair{Name: "tco.failed.names.omitted", Value: strconv.Itoa(n - len(list))})
+			}
+		}
 	}
 	sort.Slice(props, func(i, j int) bool {
 		return props[i].Name < props[j].Name
@@ -15,6 +15,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/urfave/cli"
 )
 
@@ -245,6 +246,17 @@ func (*archput) verb() string { return "APPEND" }
 
 func (a *archput) dest() string { return a.dst.bck.Cname(a.dst.oname) }
 
+func (a *archput) flags() (flags int64) {
+	switch {
+	case a.appendOnly:
+		flags = apc.ArchAppend
+	case a.appendOrPut:
+		debug.Assert(!a.appendOnly)
+		flags = apc.ArchAppendIfExist
+	}
+	return flags
+}
+
 func (a *archput) parse(c *cli.Context) (err error) {
 	a.archpath = parseStrFlag(c, archpathFlag)
 	a.appendOnly = flagIsSet(c, archAppendOnlyFlag)
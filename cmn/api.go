@@ -35,6 +35,7 @@ const (
 	PropBucketAccessAttrs  = "access"             // Bucket access attributes.
 	PropBucketVerEnabled   = "versioning.enabled" // Enable/disable object versioning in a bucket.
 	PropBucketCreated      = "created"            // Bucket creation time.
+	PropBucketMaxObjSize   = "max_objsize"        // Max object size (PUT and APPEND); zero: unlimited.
 	PropBackendBck         = "backend_bck"
 	PropBackendBckName     = PropBackendBck + ".name"
 	PropBackendBckProvider = PropBackendBck + ".provider"
@@ -53,6 +54,7 @@ type (
 		Mirror      MirrorConf      `json:"mirror"`                         // mirroring
 		Access      apc.AccessAttrs `json:"access,string"`                  // access permissions
 		Features    feat.Flags      `json:"features,string"`                // assorted features from feat.Bucket
+		MaxObjSize  cos.SizeIEC     `json:"max_objsize"`                    // max object size (PUT and APPEND); zero: unlimited
 		BID         uint64          `json:"bid,string" list:"omit"`         // unique ID
 		Created     int64           `json:"created,string" list:"readonly"` // creation timestamp
 		Versioning  VersionConf     `json:"versioning"`                     // versioning (see "inherit")
@@ -124,6 +126,7 @@ type (
 		EC          *ECConfToSet          `json:"ec,omitempty"`
 		Access      *apc.AccessAttrs      `json:"access,string,omitempty"`
 		Features    *feat.Flags           `json:"features,string,omitempty"`
+		MaxObjSize  *cos.SizeIEC          `json:"max_objsize,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
 		Extra       *ExtraToSet           `json:"extra,omitempty"`
 		Force       bool                  `json:"force,omitempty" copy:"skip" list:"omit"`
@@ -176,6 +179,7 @@ func (bck *Bck) DefaultProps(c *ClusterConfig) *Bprops {
 		EC:          c.EC,
 		WritePolicy: wp,
 		Features:    c.Features,
+		MaxObjSize:  c.MaxObjSize,
 	}
 }
 
@@ -235,6 +239,9 @@ func (bp *Bprops) Validate(targetCnt int) error {
 	if bp.Mirror.Enabled && bp.EC.Enabled {
 		nlog.Warningln("n-way mirroring and EC are both enabled at the same time on the same bucket")
 	}
+	if bp.MaxObjSize < 0 {
+		return fmt.Errorf("invalid %s %d (expecting non-negative size, zero - unlimited)", PropBucketMaxObjSize, bp.MaxObjSize)
+	}
 
 	names := bp.Features.Names()
 	for _, n := range names {
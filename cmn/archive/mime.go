@@ -35,6 +35,12 @@ const (
 	sizeDetectMime = 512
 )
 
+// PAX records (vendor-prefixed) to store archived file's checksum (see SetTarCksum)
+const (
+	PaxCksumType  = "AIS.cksum.type"
+	PaxCksumValue = "AIS.cksum.value"
+)
+
 // - here and elsewhere, mime (string) is a "." + IANA mime
 // - for standard MIME types, see: cmn/cos/http_headers.go
 // - references:
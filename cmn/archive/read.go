@@ -106,7 +106,9 @@ func (tr *tarReader) Range(filename string, rcb ReadCB) (cos.ReadCloseSizer, err
 		}
 		if filename != "" {
 			if hdr.Name == filename || namesEq(hdr.Name, filename) {
-				return &cslLimited{LimitedReader: io.LimitedReader{R: tr.tr, N: hdr.Size}}, nil
+				csl := &cslLimited{LimitedReader: io.LimitedReader{R: tr.tr, N: hdr.Size}}
+				csl.cksum = tarCksum(hdr)
+				return csl, nil
 			}
 			continue
 		}
@@ -209,6 +211,7 @@ func (lzr *lz4Reader) Range(filename string, rcb ReadCB) (cos.ReadCloseSizer, er
 
 type (
 	cslLimited struct {
+		cksum *cos.Cksum // (TAR formats only) see SetTarCksum
 		io.LimitedReader
 	}
 	cslClose struct {
@@ -226,6 +229,22 @@ type (
 // assorted 'limited' readers
 //
 
+// checksum of the archived file, if stored at archiving time (see SetTarCksum)
+func MemberCksum(r cos.ReadCloseSizer) *cos.Cksum {
+	if csl, ok := r.(*cslLimited); ok {
+		return csl.cksum
+	}
+	return nil
+}
+
+func tarCksum(hdr *tar.Header) *cos.Cksum {
+	ty, val := hdr.PAXRecords[PaxCksumType], hdr.PAXRecords[PaxCksumValue]
+	if ty == "" || val == "" {
+		return nil
+	}
+	return cos.NewCksum(ty, val)
+}
+
 func (csl *cslLimited) Size() int64 { return csl.N }
 func (*cslLimited) Close() error    { return nil } // NopCloser, unlike the other two (below)
 
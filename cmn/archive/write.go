@@ -158,6 +158,23 @@ func (tw *tarWriter) Copy(src io.Reader, _ ...int64) error {
 	return cpTar(src, tw.tw, tw.buf)
 }
 
+// store archived file's checksum in the TAR header's PAX records
+// - only when the header's format permits (see also MemberCksum)
+// - to use with archive writers, pass via Opts.CB
+func SetTarCksum(hdr *tar.Header, cksum *cos.Cksum) {
+	if cksum.IsEmpty() || cksum.Val() == "" {
+		return
+	}
+	if hdr.Format != tar.FormatUnknown && hdr.Format != tar.FormatPAX {
+		return
+	}
+	if hdr.PAXRecords == nil {
+		hdr.PAXRecords = make(map[string]string, 2)
+	}
+	hdr.PAXRecords[PaxCksumType] = cksum.Ty()
+	hdr.PAXRecords[PaxCksumValue] = cksum.Val()
+}
+
 // set Uid/Gid bits in TAR header
 // - note: cos.PermRWRR default
 // - not calling standard tar.FileInfoHeader
@@ -116,6 +116,9 @@ type (
 		// Transform (offline) or Copy src Bucket => dst bucket
 		TCB TCBConf `json:"tcb"`
 
+		// S3 API compatibility
+		S3 S3Conf `json:"s3"`
+
 		// metadata write policy: (immediate | delayed | never)
 		WritePolicy WritePolicyConf `json:"write_policy"`
 
@@ -123,6 +126,10 @@ type (
 		// to flip assorted global defaults (see cmn/feat/feat.go)
 		Features feat.Flags `json:"features,string" allow:"cluster"`
 
+		// max object size (user PUT and APPEND) - the default for all buckets
+		// (see "max_objsize" bucket property); zero (default) means unlimited
+		MaxObjSize cos.SizeIEC `json:"max_objsize"`
+
 		// read-only
 		LastUpdated string `json:"lastupdate_time"`       // timestamp
 		UUID        string `json:"uuid"`                  // UUID
@@ -153,9 +160,11 @@ type (
 		Transport   *TransportConfToSet   `json:"transport,omitempty"`
 		Memsys      *MemsysConfToSet      `json:"memsys,omitempty"`
 		TCB         *TCBConfToSet         `json:"tcb,omitempty"`
+		S3          *S3ConfToSet          `json:"s3,omitempty"`
 		WritePolicy *WritePolicyConfToSet `json:"write_policy,omitempty"`
 		Proxy       *ProxyConfToSet       `json:"proxy,omitempty"`
 		Features    *feat.Flags           `json:"features,string,omitempty"`
+		MaxObjSize  *cos.SizeIEC          `json:"max_objsize,omitempty"`
 
 		// LocalConfig
 		FSP *FSPConf `json:"fspaths,omitempty"`
@@ -314,12 +323,19 @@ type (
 		// Out-of-Space: if exceeded, the target starts failing new PUTs and keeps
 		// failing them until its local used-cap gets back below HighWM (see above)
 		OOS int64 `json:"out_of_space"`
+
+		// Soft delete: when non-zero, deleting an object from ais:// bucket moves it to the trash,
+		// from where it can be restored (see api.RestoreObject) for this long;
+		// expired trash is removed periodically and by storage cleanup; under space pressure
+		// (LRU, out-of-space) - regardless of expiration (zero - disabled, the default)
+		TrashTTL cos.Duration `json:"trash_ttl"`
 	}
 	SpaceConfToSet struct {
-		CleanupWM *int64 `json:"cleanupwm,omitempty"`
-		LowWM     *int64 `json:"lowwm,omitempty"`
-		HighWM    *int64 `json:"highwm,omitempty"`
-		OOS       *int64 `json:"out_of_space,omitempty"`
+		CleanupWM *int64        `json:"cleanupwm,omitempty"`
+		LowWM     *int64        `json:"lowwm,omitempty"`
+		HighWM    *int64        `json:"highwm,omitempty"`
+		OOS       *int64        `json:"out_of_space,omitempty"`
+		TrashTTL  *cos.Duration `json:"trash_ttl,omitempty"`
 	}
 
 	LRUConf struct {
@@ -485,12 +501,18 @@ type (
 	}
 
 	AuthConf struct {
-		Secret  string `json:"secret"`
-		Enabled bool   `json:"enabled"`
+		Secret string `json:"secret"`
+		// S3 API: when both are defined, presigned (SigV4 query-param) requests
+		// are verified against these credentials
+		S3AccessKey string `json:"s3_access_key,omitempty"`
+		S3SecretKey string `json:"s3_secret_key,omitempty"`
+		Enabled     bool   `json:"enabled"`
 	}
 	AuthConfToSet struct {
-		Secret  *string `json:"secret,omitempty"`
-		Enabled *bool   `json:"enabled,omitempty"`
+		Secret      *string `json:"secret,omitempty"`
+		S3AccessKey *string `json:"s3_access_key,omitempty"`
+		S3SecretKey *string `json:"s3_secret_key,omitempty"`
+		Enabled     *bool   `json:"enabled,omitempty"`
 	}
 
 	// keepalive tracker
@@ -589,10 +611,47 @@ type (
 	TCBConf struct {
 		Compression string `json:"compression"`       // enum { CompressAlways, ... } in api/apc/compression.go
 		SbundleMult int    `json:"bundle_multiplier"` // stream-bundle multiplier: num streams to destination
+		// ETL bucket: number of concurrent transformations per mountpath;
+		// zero (default) means "auto" - derive from the number of mountpaths and their current utilization
+		Parallelism int `json:"parallelism"`
+		// multi-object copy/transform: max number of failed object names to keep and report
+		// via job stats (zero means default - see apc.DfltTCOFailedNames)
+		MaxFailedNames int `json:"max_failed_names"`
+		// copying objects to other targets: objects of (at least) this size are copied in chunks, via concurrent
+		// range writes reassembled at the destination; zero (default) disables
+		ChunkThreshold cos.SizeIEC `json:"chunk_threshold"`
+		// number of concurrent chunks per object (zero means default - see apc.DfltTCBChunkWorkers)
+		ChunkWorkers int `json:"chunk_workers"`
+		// when compressing: enum { CompressLevelFast (default), CompressLevelHigh } in api/apc/compression.go
+		CompressionLevel string `json:"compression_level"`
 	}
 	TCBConfToSet struct {
-		Compression *string `json:"compression,omitempty"`
-		SbundleMult *int    `json:"bundle_multiplier,omitempty"`
+		Compression      *string      `json:"compression,omitempty"`
+		SbundleMult      *int         `json:"bundle_multiplier,omitempty"`
+		Parallelism      *int         `json:"parallelism,omitempty"`
+		MaxFailedNames   *int         `json:"max_failed_names,omitempty"`
+		ChunkThreshold   *cos.SizeIEC `json:"chunk_threshold,omitempty"`
+		ChunkWorkers     *int         `json:"chunk_workers,omitempty"`
+		CompressionLevel *string      `json:"compression_level,omitempty"`
+	}
+
+	// S3 PutObject: `x-amz-storage-class` => object's redundancy
+	S3Conf struct {
+		// comma-separated storage classes (e.g. "REDUCED_REDUNDANCY,ONEZONE_IA") that map to no redundancy:
+		// the object is neither mirrored nor erasure coded (regardless of bucket props);
+		// all other classes, including STANDARD and unknown, map to the bucket defaults
+		ReducedRedundancy string `json:"reduced_redundancy"`
+		// region to report via HeadBucket (`x-amz-bucket-region`), e.g. to tell apart
+		// multiple clusters; empty (default) means s3.AISRegion
+		Region string `json:"region"`
+		// ListObjectsV2: when listing page N fails, return pages 1..N-1 (truncated, with the continuation token
+		// pointing at the failed page) instead of failing the entire request; false (default): all or error
+		PartialList bool `json:"partial_list"`
+	}
+	S3ConfToSet struct {
+		ReducedRedundancy *string `json:"reduced_redundancy,omitempty"`
+		Region            *string `json:"region,omitempty"`
+		PartialList       *bool   `json:"partial_list,omitempty"`
 	}
 
 	WritePolicyConf struct {
@@ -660,6 +719,7 @@ var (
 	_ Validator = (*TransportConf)(nil)
 	_ Validator = (*MemsysConf)(nil)
 	_ Validator = (*TCBConf)(nil)
+	_ Validator = (*S3Conf)(nil)
 	_ Validator = (*WritePolicyConf)(nil)
 
 	_ PropsValidator = (*CksumConf)(nil)
@@ -698,6 +758,9 @@ func (c *Config) Validate() error {
 	if err := c.LocalConfig.TestFSP.Validate(c); err != nil {
 		return err
 	}
+	if c.MaxObjSize < 0 {
+		return fmt.Errorf("invalid max_objsize %d (expecting non-negative size, zero - unlimited)", c.MaxObjSize)
+	}
 
 	opts := IterOpts{VisitAll: true}
 	return IterFields(c, vdate, opts)
@@ -1009,6 +1072,9 @@ func (c *SpaceConf) Validate() (err error) {
 	if c.CleanupWM <= 0 || c.LowWM < c.CleanupWM || c.HighWM < c.LowWM || c.OOS < c.HighWM || c.OOS > 100 {
 		err = fmt.Errorf("invalid %s (expecting: 0 < cleanup < low < high < OOS < 100)", c)
 	}
+	if c.TrashTTL < 0 {
+		err = fmt.Errorf("invalid space.trash_ttl=%v (expecting non-negative duration)", c.TrashTTL)
+	}
 	return
 }
 
@@ -1579,9 +1645,65 @@ func (c *TCBConf) Validate() error {
 		return fmt.Errorf("invalid tcb.compression: %q (expecting one of: %v)",
 			c.Compression, apc.SupportedCompression)
 	}
+	if c.Parallelism < 0 || c.Parallelism > apc.MaxTCBParallelism {
+		return fmt.Errorf("invalid tcb.parallelism: %d (expected range [0, %d])", c.Parallelism, apc.MaxTCBParallelism)
+	}
+	if c.MaxFailedNames < 0 || c.MaxFailedNames > apc.MaxTCOFailedNames {
+		return fmt.Errorf("invalid tcb.max_failed_names: %d (expected range [0, %d])",
+			c.MaxFailedNames, apc.MaxTCOFailedNames)
+	}
+	if c.ChunkThreshold != 0 && c.ChunkThreshold < cos.MiB {
+		return fmt.Errorf("invalid tcb.chunk_threshold: %s (expecting zero - disabled - or at least 1MiB)", c.ChunkThreshold)
+	}
+	if c.ChunkWorkers < 0 || c.ChunkWorkers > apc.MaxTCBChunkWorkers {
+		return fmt.Errorf("invalid tcb.chunk_workers: %d (expected range [0, %d])", c.ChunkWorkers, apc.MaxTCBChunkWorkers)
+	}
+	if !apc.IsValidCompressionLevel(c.CompressionLevel) {
+		return fmt.Errorf("invalid tcb.compression_level: %q (expecting one of: %v)",
+			c.CompressionLevel, apc.SupportedCompressionLevel)
+	}
 	return nil
 }
 
+////////////
+// S3Conf //
+////////////
+
+func (c *S3Conf) Validate() error {
+	for _, class := range strings.Split(c.ReducedRedundancy, ",") {
+		class = strings.TrimSpace(class)
+		if class == "" {
+			continue
+		}
+		for _, ch := range class {
+			if (ch < 'A' || ch > 'Z') && (ch < '0' || ch > '9') && ch != '_' {
+				return fmt.Errorf("invalid s3.reduced_redundancy: storage class %q (expecting uppercase letters, digits, and underscores)",
+					class)
+			}
+		}
+	}
+	for _, ch := range c.Region {
+		if (ch < 'a' || ch > 'z') && (ch < '0' || ch > '9') && ch != '-' {
+			return fmt.Errorf("invalid s3.region %q (expecting lowercase letters, digits, and dashes, e.g. \"us-east-1\")",
+				c.Region)
+		}
+	}
+	return nil
+}
+
+// whether objects of a given storage class are to be written with no redundancy
+func (c *S3Conf) IsReduced(class string) bool {
+	if class == "" || c.ReducedRedundancy == "" {
+		return false
+	}
+	for _, s := range strings.Split(c.ReducedRedundancy, ",") {
+		if strings.TrimSpace(s) == class {
+			return true
+		}
+	}
+	return false
+}
+
 /////////////////
 // TimeoutConf //
 /////////////////
@@ -40,6 +40,20 @@ const (
 	HdrLocation  = "Location"
 	HdrServer    = "Server"
 	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/ETag
+
+	HdrRetryAfter = "Retry-After" // Ref: https://www.rfc-editor.org/rfc/rfc9110#section-10.2.3
+
+	// representation & caching (stored with the object via S3 PutObject - see ais/s3/stdhdr.go)
+	HdrCacheControl       = "Cache-Control"
+	HdrContentDisposition = "Content-Disposition"
+	HdrContentEncoding    = "Content-Encoding"
+	HdrExpires            = "Expires"
+
+	// conditional requests (Ref: https://www.rfc-editor.org/rfc/rfc7232)
+	HdrIfNoneMatch       = "If-None-Match"
+	HdrIfModifiedSince   = "If-Modified-Since"
+	HdrIfMatch           = "If-Match"
+	HdrIfUnmodifiedSince = "If-Unmodified-Since"
 )
 
 //
@@ -59,8 +73,10 @@ const (
 	S3VersionHeader = "x-amz-version-id"
 
 	// s3 api request headers
-	S3HdrObjSrc = "x-amz-copy-source"
-	S3HdrMptCnt = "x-amz-mp-parts-count"
+	S3HdrObjSrc      = "x-amz-copy-source"
+	S3HdrObjSrcRange = "x-amz-copy-source-range" // UploadPartCopy: source byte range, e.g. "bytes=0-1048575"
+	S3HdrMptCnt      = "x-amz-mp-parts-count"
+	S3HdrObjAttrs    = "x-amz-object-attributes" // GetObjectAttributes: comma-separated list
 
 	// https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
 	S3UnsignedPayload  = "UNSIGNED-PAYLOAD"
@@ -0,0 +1,159 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"runtime/debug"
+	"sync"
+	"syscall"
+)
+
+// MmapFileHandle reads a (read-only, shared) memory-mapped file.
+// Compared with FileHandle, it saves a copy whenever the consumer can take
+// the mapped bytes as is (see bytes.Reader.WriteTo).
+// NOTE: mapping fails for empty files and on filesystems that don't support mmap -
+// callers are expected to fall back to NewFileHandle.
+//
+// Lifecycle: consumers (e.g., net/http transport) may keep reading in a separate goroutine
+// after (or while) the handle gets closed. Therefore, each read holds a reference, and the
+// memory is unmapped only when the handle is closed _and_ the last in-flight read returns;
+// reads that start after Close fail with os.ErrClosed.
+//
+// Truncation hazard: if the underlying file gets truncated (or replaced in place) while mapped,
+// accessing the pages beyond the new EOF raises SIGBUS. Reads recover from the fault and return
+// ErrMmapFault - the content read so far must be considered invalid.
+type MmapFileHandle struct {
+	r      *bytes.Reader
+	b      []byte
+	fqn    string
+	mu     sync.Mutex
+	refs   int
+	closed bool
+}
+
+var ErrMmapFault = errors.New("memory-mapped file: fault (truncated?)")
+
+// interface guard
+var (
+	_ ReadOpenCloser = (*MmapFileHandle)(nil)
+	_ io.ReaderAt    = (*MmapFileHandle)(nil)
+	_ io.Seeker      = (*MmapFileHandle)(nil)
+	_ io.WriterTo    = (*MmapFileHandle)(nil)
+)
+
+func NewMmapFileHandle(fqn string) (*MmapFileHandle, error) {
+	file, err := os.Open(fqn)
+	if err != nil {
+		return nil, err
+	}
+	finfo, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	size := finfo.Size()
+	if size <= 0 || int64(int(size)) != size {
+		file.Close()
+		return nil, errors.New("cannot mmap " + fqn + ": invalid size " + ToSizeIEC(size, 0))
+	}
+	b, err := syscall.Mmap(int(file.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED)
+	file.Close() // (the mapping remains valid)
+	if err != nil {
+		return nil, &os.PathError{Op: "mmap", Path: fqn, Err: err}
+	}
+	return &MmapFileHandle{r: bytes.NewReader(b), b: b, fqn: fqn}, nil
+}
+
+func (f *MmapFileHandle) Open() (ReadOpenCloser, error) { return NewMmapFileHandle(f.fqn) }
+
+func (f *MmapFileHandle) Read(p []byte) (n int, err error) {
+	if err = f.acquire(); err != nil {
+		return 0, err
+	}
+	defer f.release(debug.SetPanicOnFault(true), &err)
+	return f.r.Read(p)
+}
+
+func (f *MmapFileHandle) ReadAt(p []byte, off int64) (n int, err error) {
+	if err = f.acquire(); err != nil {
+		return 0, err
+	}
+	defer f.release(debug.SetPanicOnFault(true), &err)
+	return f.r.ReadAt(p, off)
+}
+
+func (f *MmapFileHandle) WriteTo(w io.Writer) (n int64, err error) {
+	if err = f.acquire(); err != nil {
+		return 0, err
+	}
+	defer f.release(debug.SetPanicOnFault(true), &err)
+	return f.r.WriteTo(w)
+}
+
+func (f *MmapFileHandle) Seek(offset int64, whence int) (int64, error) {
+	if err := f.acquire(); err != nil {
+		return 0, err
+	}
+	defer f.release(debug.SetPanicOnFault(true), nil)
+	return f.r.Seek(offset, whence)
+}
+
+// idempotent; unmaps right away unless there are reads in progress (in which
+// case the last one to finish does)
+func (f *MmapFileHandle) Close() (err error) {
+	f.mu.Lock()
+	if !f.closed {
+		f.closed = true
+		if f.refs == 0 {
+			err = f.unmap()
+		}
+	}
+	f.mu.Unlock()
+	return err
+}
+
+func (f *MmapFileHandle) acquire() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		return os.ErrClosed
+	}
+	f.refs++
+	return nil
+}
+
+// is deferred by each read; converts SIGBUS (see "truncation hazard" above) into ErrMmapFault
+func (f *MmapFileHandle) release(panicOnFault bool, err *error) {
+	r := recover()
+	debug.SetPanicOnFault(panicOnFault)
+
+	f.mu.Lock()
+	f.refs--
+	if f.closed && f.refs == 0 {
+		f.unmap()
+	}
+	f.mu.Unlock()
+
+	if r == nil {
+		return
+	}
+	if _, ok := r.(interface{ Addr() uintptr }); !ok || err == nil {
+		panic(r)
+	}
+	*err = &os.PathError{Op: "read", Path: f.fqn, Err: ErrMmapFault}
+}
+
+func (f *MmapFileHandle) unmap() (err error) {
+	if f.b != nil {
+		err = syscall.Munmap(f.b)
+		f.b = nil
+		f.r = bytes.NewReader(nil)
+	}
+	return err
+}
@@ -0,0 +1,193 @@
+//go:build linux
+
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"golang.org/x/sys/unix"
+)
+
+func TestMmapFileHandle(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		fqn  = filepath.Join(dir, "file")
+		data = make([]byte, 3*cos.MiB+17)
+	)
+	_, err := rand.Read(data)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, os.WriteFile(fqn, data, cos.PermRWR))
+
+	fh, err := cos.NewMmapFileHandle(fqn)
+	tassert.CheckFatal(t, err)
+	b, err := io.ReadAll(fh)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(b, data), "read content differs")
+
+	// reopen and read again
+	roc, err := fh.Open()
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, fh.Close())
+	b, err = io.ReadAll(roc)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(b, data), "(re)read content differs")
+	tassert.CheckFatal(t, roc.Close())
+	tassert.CheckFatal(t, roc.Close()) // idempotent
+
+	// empty file cannot be mapped
+	empty := filepath.Join(dir, "empty")
+	tassert.CheckFatal(t, os.WriteFile(empty, nil, cos.PermRWR))
+	_, err = cos.NewMmapFileHandle(empty)
+	tassert.Fatalf(t, err != nil, "expecting error mapping empty file")
+
+	// read after close
+	_, err = roc.Read(make([]byte, 1))
+	tassert.Fatalf(t, errors.Is(err, os.ErrClosed), "expecting ErrClosed, got %v", err)
+}
+
+// slow consumer that keeps reading the mapped memory after the handle is closed
+// (compare w/ net/http transport writing request body)
+type slowWriter struct {
+	bytes.Buffer
+	started chan struct{}
+}
+
+func (w *slowWriter) Write(p []byte) (int, error) {
+	if w.started != nil {
+		close(w.started)
+		w.started = nil
+	}
+	time.Sleep(100 * time.Millisecond)
+	return w.Buffer.Write(p)
+}
+
+func TestMmapCloseInflight(t *testing.T) {
+	var (
+		fqn  = filepath.Join(t.TempDir(), "file")
+		data = make([]byte, cos.MiB)
+	)
+	_, err := rand.Read(data)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, os.WriteFile(fqn, data, cos.PermRWR))
+
+	fh, err := cos.NewMmapFileHandle(fqn)
+	tassert.CheckFatal(t, err)
+	var (
+		w    = &slowWriter{started: make(chan struct{})}
+		ch   = w.started
+		done = make(chan error, 1)
+	)
+	go func() {
+		_, err := fh.WriteTo(w)
+		done <- err
+	}()
+	<-ch
+	tassert.CheckFatal(t, fh.Close()) // must not unmap under the writer
+	tassert.CheckFatal(t, <-done)
+	tassert.Fatalf(t, bytes.Equal(w.Bytes(), data), "written content differs")
+
+	_, err = fh.WriteTo(io.Discard)
+	tassert.Fatalf(t, errors.Is(err, os.ErrClosed), "expecting ErrClosed, got %v", err)
+}
+
+func TestMmapTruncated(t *testing.T) {
+	var (
+		fqn  = filepath.Join(t.TempDir(), "file")
+		data = make([]byte, 4*cos.MiB)
+	)
+	tassert.CheckFatal(t, os.WriteFile(fqn, data, cos.PermRWR))
+	fh, err := cos.NewMmapFileHandle(fqn)
+	tassert.CheckFatal(t, err)
+	defer fh.Close()
+
+	tassert.CheckFatal(t, os.Truncate(fqn, 0))
+	_, err = io.ReadAll(fh) // (SIGBUS => error)
+	tassert.Fatalf(t, errors.Is(err, cos.ErrMmapFault), "expecting fault, got %v", err)
+}
+
+// reads (and hashes) every byte, to make sure mapped pages are actually touched
+type sumSink struct{ sum byte }
+
+func (s *sumSink) Write(p []byte) (int, error) {
+	for _, c := range p {
+		s.sum ^= c
+	}
+	return len(p), nil
+}
+
+// go test -bench=BenchmarkFileRead -benchtime=20x ./cmn/cos/
+func BenchmarkFileRead(b *testing.B) {
+	const size = 256 * cos.MiB
+	var (
+		fqn  = filepath.Join(b.TempDir(), "file")
+		data = make([]byte, size)
+	)
+	if _, err := rand.Read(data); err != nil {
+		b.Fatal(err)
+	}
+	if err := os.WriteFile(fqn, data, cos.PermRWR); err != nil {
+		b.Fatal(err)
+	}
+	bench := []struct {
+		name string
+		open func(string) (cos.ReadOpenCloser, error)
+	}{
+		{"std", func(fqn string) (cos.ReadOpenCloser, error) { return cos.NewFileHandle(fqn) }},
+		{"mmap", func(fqn string) (cos.ReadOpenCloser, error) { return cos.NewMmapFileHandle(fqn) }},
+	}
+	for _, bm := range bench {
+		for _, cold := range []bool{false, true} {
+			name := bm.name
+			if cold {
+				name += "-cold"
+			}
+			b.Run(name, func(b *testing.B) {
+				sink := &sumSink{}
+				b.SetBytes(size)
+				b.ResetTimer()
+				for range b.N {
+					if cold {
+						b.StopTimer()
+						dropPageCache(b, fqn)
+						b.StartTimer()
+					}
+					roc, err := bm.open(fqn)
+					if err != nil {
+						b.Fatal(err)
+					}
+					// io.Copy uses WriterTo when available (mmap: no intermediate buffer)
+					if _, err := io.Copy(sink, roc); err != nil {
+						b.Fatal(err)
+					}
+					roc.Close()
+				}
+			})
+		}
+	}
+}
+
+// evict the file's (clean) pages from the page cache
+func dropPageCache(b *testing.B, fqn string) {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		b.Fatal(err)
+	}
+	err = unix.Fadvise(int(fh.Fd()), 0, 0, unix.FADV_DONTNEED)
+	fh.Close()
+	if err != nil {
+		b.Fatal(err)
+	}
+}
@@ -101,6 +101,11 @@ type (
 		usedPct        int32
 		oos            bool
 	}
+	ErrObjTooLarge struct {
+		name  string
+		size  int64
+		limit int64
+	}
 	ErrBucketAccessDenied struct{ errAccessDenied }
 	ErrObjectAccessDenied struct{ errAccessDenied }
 	errAccessDenied       struct {
@@ -434,6 +439,22 @@ func IsErrCapExceeded(err error) bool {
 	return ok
 }
 
+// ErrObjTooLarge
+
+func NewErrObjTooLarge(name string, size, limit int64) *ErrObjTooLarge {
+	return &ErrObjTooLarge{name: name, size: size, limit: limit}
+}
+
+func (e *ErrObjTooLarge) Error() string {
+	return fmt.Sprintf("%s: size %s exceeds maximum object size %s (see %q bucket property)", e.name,
+		cos.ToSizeIEC(e.size, 2), cos.ToSizeIEC(e.limit, 2), PropBucketMaxObjSize)
+}
+
+func IsErrObjTooLarge(err error) bool {
+	_, ok := err.(*ErrObjTooLarge)
+	return ok
+}
+
 // ErrInvalidCksum
 
 func (e *ErrInvalidCksum) Error() string {
@@ -36,6 +36,7 @@ const (
 	IgnoreLimitedCoexistence  // run in presence of "limited coexistence" type conflicts (same as e.g. CopyBckMsg.Force but globally)
 	PresignedS3Req            // (*) pass-through client-signed (presigned) S3 requests for subsequent authentication by S3
 	DontOptimizeVirtSubdir    // when prefix has no trailing '/' and is a subdir do not assume there are no "subdir..." named obj-s
+	S3ETagMD5                 // (*) S3 PUT: compute md5 in addition to the bucket-configured checksum and return it as ETag
 )
 
 var Cluster = []string{
@@ -50,6 +51,7 @@ var Cluster = []string{
 	"Ignore-LimitedCoexistence-Conflicts",
 	"Presigned-S3-Req",
 	"Dont-Optimize-Virt-Subdir",
+	"S3-ETag-MD5",
 	// "none" ====================
 }
 
@@ -57,6 +59,7 @@ var Bucket = []string{
 	"Skip-Loading-VersionChecksum-MD",
 	"Fsync-PUT",
 	"Presigned-S3-Req",
+	"S3-ETag-MD5",
 	// "none" ====================
 }
 
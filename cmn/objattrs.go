@@ -35,6 +35,22 @@ const (
 
 	OrigURLObjMD = "orig_url"
 
+	// S3 object tags (`?tagging` API) are stored as custom keys with this reserved prefix
+	S3TagObjMD = "s3-tag:"
+
+	// S3 storage class (`x-amz-storage-class`) the object was written with, if other than STANDARD
+	S3StorageClassObjMD = "s3-storage-class"
+
+	// S3 PutObject standard headers that are stored as is and returned with GET and HEAD
+	S3CacheControlObjMD       = "s3-cache-control"
+	S3ContentDispositionObjMD = "s3-content-disposition"
+	S3ContentEncodingObjMD    = "s3-content-encoding"
+	S3ExpiresObjMD            = "s3-expires"
+
+	// object's expiration time (RFC 3339), e.g. as per 'ais object put --ttl'
+	// (reserved custom key; note: expired objects are not yet automatically removed)
+	ExpiresAtObjMD = "expires-at"
+
 	// additional backend
 	LastModified = "LastModified"
 )
@@ -105,6 +121,7 @@ func S2CustomMD(custom, version string) (md cos.StrKVs) {
 	parseCustom(md, lst, CRC32CObjMD)
 	parseCustom(md, lst, MD5ObjMD)
 	parseCustom(md, lst, ETag)
+	parseCustom(md, lst, S3StorageClassObjMD)
 	return md
 }
 
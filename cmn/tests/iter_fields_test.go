@@ -97,9 +97,10 @@ var _ = Describe("IterFields", func() {
 					"extra.aws.profile":      "",
 					"extra.aws.max_pagesize": uint(0),
 
-					"access":   apc.AccessAttrs(0),
-					"features": feat.Flags(0),
-					"created":  int64(0),
+					"access":      apc.AccessAttrs(0),
+					"features":    feat.Flags(0),
+					"created":     int64(0),
+					"max_objsize": cos.SizeIEC(0),
 
 					"write_policy.data": apc.WritePolicy(""),
 					"write_policy.md":   apc.WritePolicy(""),
@@ -151,8 +152,9 @@ var _ = Describe("IterFields", func() {
 					"lru.dont_evict_time":   (*cos.Duration)(nil),
 					"lru.capacity_upd_time": (*cos.Duration)(nil),
 
-					"access":   apc.Ptr[apc.AccessAttrs](1024),
-					"features": apc.Ptr[feat.Flags](1024),
+					"access":      apc.Ptr[apc.AccessAttrs](1024),
+					"features":    apc.Ptr[feat.Flags](1024),
+					"max_objsize": (*cos.SizeIEC)(nil),
 
 					"write_policy.data": (*apc.WritePolicy)(nil),
 					"write_policy.md":   apc.Ptr(apc.WriteDelayed),
@@ -72,6 +72,7 @@ var _ = Describe("LOM", func() {
 
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.TrashType, &fs.TrashContentResolver{}, true)
 
 	bmd := mock.NewBaseBownerMock(
 		meta.NewBck(
@@ -994,6 +995,70 @@ var _ = Describe("LOM", func() {
 		})
 	})
 
+	Describe("soft delete", func() {
+		const (
+			testObjectName = "foldr/trash-obj.ext"
+			testFileSize   = 123
+		)
+		var lom *core.LOM
+
+		newLOM := func() *core.LOM {
+			l := &core.LOM{ObjName: testObjectName}
+			Expect(l.InitBck(&localBckB)).NotTo(HaveOccurred())
+			return l
+		}
+		trash := func(l *core.LOM) {
+			l.Lock(true)
+			defer l.Unlock(true)
+			Expect(l.MoveToTrash()).NotTo(HaveOccurred())
+			Expect(l.FQN).NotTo(BeAnExistingFile())
+			Expect(l.TrashFQN()).To(BeARegularFile())
+		}
+		restore := func(ttl time.Duration) (*core.LOM, error) {
+			l := newLOM()
+			l.Lock(true)
+			defer l.Unlock(true)
+			return l, l.RestoreFromTrash(ttl)
+		}
+
+		BeforeEach(func() {
+			lom = filePut(newLOM().FQN, testFileSize)
+		})
+
+		It("should restore trashed object", func() {
+			trash(lom)
+			restored, err := restore(time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored.FQN).To(BeARegularFile())
+			Expect(restored.SizeBytes()).To(BeEquivalentTo(testFileSize))
+			Expect(restored.TrashFQN()).NotTo(BeAnExistingFile())
+		})
+
+		It("should fail to restore when overwritten", func() {
+			trash(lom)
+			filePut(lom.FQN, testFileSize*2)
+
+			_, err := restore(time.Hour)
+			Expect(err).To(HaveOccurred())
+			Expect(lom.TrashFQN()).To(BeARegularFile()) // (still in trash)
+
+			current := newLOM()
+			Expect(current.Load(false, false)).NotTo(HaveOccurred())
+			Expect(current.SizeBytes()).To(BeEquivalentTo(testFileSize * 2))
+		})
+
+		It("should fail to restore when expired", func() {
+			trash(lom)
+			deleted := time.Now().Add(-2 * time.Hour)
+			Expect(os.Chtimes(lom.TrashFQN(), deleted, deleted)).NotTo(HaveOccurred())
+
+			_, err := restore(time.Hour)
+			Expect(err).To(HaveOccurred())
+			Expect(cos.IsNotExist(err, 0)).To(BeTrue())
+			Expect(lom.FQN).NotTo(BeAnExistingFile())
+		})
+	})
+
 	Describe("local and cloud bucket with the same name", func() {
 		It("should have different fqn", func() {
 			testObject := "foldr/test-obj.ext"
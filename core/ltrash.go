@@ -0,0 +1,81 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Soft delete (see config.Space.TrashTTL):
+// - the object (sans copies) is moved to the same mountpath's trash (fs.TrashType)
+//   with its metadata intact; mtime of the trashed file is the time of deletion
+// - until expired and removed by storage cleanup, the object can be restored
+//   (renamed back) - unless, that is, the same name has been written again
+
+func (lom *LOM) TrashFQN() string { return lom.mi.MakePathFQN(lom.Bucket(), fs.TrashType, lom.ObjName) }
+
+// (compare with lom.Remove)
+func (lom *LOM) MoveToTrash() error {
+	debug.AssertFunc(func() bool {
+		_, exclusive := lom.IsLocked()
+		return exclusive
+	})
+	lom.Uncache()
+	for copyFQN := range lom.md.copies {
+		if copyFQN == lom.FQN {
+			continue
+		}
+		if err := cos.RemoveFile(copyFQN); err != nil {
+			return err
+		}
+	}
+	if lom.md.copies != nil {
+		lom.md.copies = nil
+		buf := lom.marshal()
+		err := fs.SetXattr(lom.FQN, XattrLOM, buf)
+		g.smm.Free(buf)
+		if err != nil {
+			return err
+		}
+	}
+	tfqn := lom.TrashFQN()
+	if err := cos.Rename(lom.FQN, tfqn); err != nil {
+		return cmn.NewErrFailedTo(T, "move to trash", lom, err)
+	}
+	now := time.Now()
+	if err := os.Chtimes(tfqn, now, now); err != nil {
+		nlog.Errorln("failed to set deletion time:", err) // (will expire sooner)
+	}
+	lom.md.bckID = 0
+	return nil
+}
+
+// NOTE: caller must wlock
+func (lom *LOM) RestoreFromTrash(ttl time.Duration) error {
+	tfqn := lom.TrashFQN()
+	finfo, err := os.Stat(tfqn)
+	if err != nil || time.Since(finfo.ModTime()) > ttl {
+		if err == nil || os.IsNotExist(err) {
+			err = cos.NewErrNotFound(T, lom.Cname()+" in trash")
+		}
+		return err
+	}
+	if err := cos.Stat(lom.FQN); err == nil {
+		return errors.New(lom.Cname() + " already exists (cannot restore from trash)")
+	}
+	if err := lom.RenameFrom(tfqn); err != nil {
+		return err
+	}
+	lom.Uncache()
+	return lom.Load(true /*cache it*/, true /*locked*/)
+}
@@ -120,5 +120,13 @@ type (
 		DryRun    bool
 		LatestVer bool // can be used without changing bucket's 'versioning.validate_warm_get'; see also: QparamLatestVer
 		Sync      bool // ditto -  bucket's 'versioning.synchronize'
+		// (optional) destination's custom metadata that replaces the source's one
+		// (e.g., S3 CopyObject with `x-amz-metadata-directive: REPLACE`)
+		CustomMD cos.StrKVs
+		// (optional) completion callback: invoked exactly once with the copy's final result;
+		// when sending via data mover - asynchronously, upon transmission completion
+		OnSent func(err error)
+		// (optional) bandwidth limiting: is called prior to transmitting (or copying) the specified number of bytes
+		Throttle func(n int64)
 	}
 )
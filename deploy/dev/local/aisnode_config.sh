@@ -63,7 +63,8 @@ cat > $AIS_CONF_FILE <<EOL
 		"cleanupwm":         65,
 		"lowwm":             75,
 		"highwm":            90,
-		"out_of_space":      95
+		"out_of_space":      95,
+		"trash_ttl":         "0s"
 	},
 	"lru": {
 		"dont_evict_time":   "120m",
@@ -169,13 +170,24 @@ cat > $AIS_CONF_FILE <<EOL
 	},
 	"tcb": {
 		"compression":		"never",
-		"bundle_multiplier":	2
+		"bundle_multiplier":	2,
+		"parallelism":		0,
+		"max_failed_names":	0,
+		"chunk_threshold":	"0",
+		"chunk_workers":	0,
+		"compression_level":	"fast"
+	},
+	"s3": {
+		"reduced_redundancy":	"REDUCED_REDUNDANCY",
+		"region":		"",
+		"partial_list":		false
 	},
 	"write_policy": {
 		"data": "${WRITE_POLICY_DATA:-}",
 		"md": "${WRITE_POLICY_MD:-}"
 	},
-	"features": "0"
+	"features": "0",
+	"max_objsize": "0"
 }
 EOL
 
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -38,6 +39,7 @@ const (
 	WorkfileType = "wk"
 	ECSliceType  = "ec"
 	ECMetaType   = "mt"
+	TrashType    = "tr" // soft-deleted objects (see 'space.trash_ttl')
 )
 
 type (
@@ -177,6 +179,7 @@ type (
 	WorkfileContentResolver struct{}
 	ECSliceContentResolver  struct{}
 	ECMetaContentResolver   struct{}
+	TrashContentResolver    struct{}
 )
 
 func (*ObjectContentResolver) PermToMove() bool                   { return true }
@@ -251,3 +254,63 @@ func (*ECMetaContentResolver) GenUniqueFQN(base, _ string) string { return base
 func (*ECMetaContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
 	return base, false, true
 }
+
+// trash: same name as the (deleted) object; expiration is handled by space cleanup
+func (*TrashContentResolver) PermToMove() bool    { return false }
+func (*TrashContentResolver) PermToEvict() bool   { return false }
+func (*TrashContentResolver) PermToProcess() bool { return false }
+
+func (*TrashContentResolver) GenUniqueFQN(base, _ string) string { return base }
+
+func (*TrashContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	return base, false, true
+}
+
+// Named workfiles - unlike the unique ones (see GenUniqueFQN above) - are named by their respective
+// owners, survive restarts, and are normally removed by the owners as well.
+// Leftovers (e.g., checkpoints of aborted copy jobs that were never resumed) get removed
+// by space cleanup when not updated for longer than the respective time-to-live.
+const (
+	WorkTcbCkptPrefix = "tcb-ckpt-" // x-tcb checkpoints (+ xaction ID)
+	WorkS3MptPrefix   = "s3mpt-"    // S3 multipart uploads: manifest and parts (+ upload ID)
+	WorkS3MptSuffix   = ".mpt"
+)
+
+var namedWork = [...]struct {
+	prefix string
+	ttl    time.Duration
+	owner  func(base string) string // (optional) the file that determines the age, e.g. part => manifest
+}{
+	{WorkTcbCkptPrefix, 72 * time.Hour, nil},
+	{WorkS3MptPrefix, 7 * 24 * time.Hour, s3MptManifest},
+}
+
+// returns time-to-live and the name of the file (in the same directory) whose mtime
+// determines the age of the named workfile (the latter is `base` itself unless owned)
+func NamedWorkTTL(base string) (ttl time.Duration, owner string, ok bool) {
+	for _, nw := range namedWork {
+		if !strings.HasPrefix(base, nw.prefix) {
+			continue
+		}
+		owner = base
+		if nw.owner != nil {
+			owner = nw.owner(base)
+		}
+		return nw.ttl, owner, true
+	}
+	return 0, "", false
+}
+
+// s3mpt-<upload-id>.<part-number>.mpt => s3mpt-<upload-id>.mpt
+// (parts of an active upload may be older than TTL - the manifest gets updated upon each added part)
+func s3MptManifest(base string) string {
+	s := strings.TrimSuffix(base, WorkS3MptSuffix)
+	i := strings.LastIndexByte(s, '.')
+	if i < 0 || len(s) == len(base) {
+		return base
+	}
+	if _, err := strconv.ParseUint(s[i+1:], 10, 32); err != nil {
+		return base
+	}
+	return s[:i] + WorkS3MptSuffix
+}
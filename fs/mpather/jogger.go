@@ -60,6 +60,12 @@ type (
 		PerBucket             bool     // num joggers = (num mountpaths) x (num buckets)
 		SkipGloballyMisplaced bool     // skip globally misplaced
 		Throttle              bool     // true: pace itself depending on disk utilization
+
+		// traverse each mountpath in the (per-directory) lexicographical order, and
+		// optionally skip objects that precede (or equal) per-mountpath StartAfter (mountpath => object name) -
+		// e.g., when resuming from a checkpoint; single bucket only
+		Sorted     bool
+		StartAfter map[string]string
 	}
 
 	// Jgroup runs jogger per mountpath which walk the entire bucket and
@@ -85,6 +91,7 @@ type (
 		stopCh    cos.StopCh
 		bufs      [][]byte
 		num       int64
+		after     string // (optional) skip objects up to and including (see JgroupOpts.StartAfter)
 	}
 
 	joggerSyncGroup struct {
@@ -196,6 +203,11 @@ func newJogger(ctx context.Context, opts *JgroupOpts, mi *fs.Mountpath, config *
 		j.bdir = mi.MakePathCT(&j.opts.Bck, fs.ObjectType) // this mountpath's bucket dir that contains objects
 		j.objPrefix = filepath.Join(j.bdir, opts.Prefix)
 	}
+	if after, ok := opts.StartAfter[mi.Path]; ok && after != "" {
+		debug.Assert(opts.Sorted && len(opts.Buckets) == 0 && !opts.Bck.IsQuery())
+		j.bdir = mi.MakePathCT(&j.opts.Bck, fs.ObjectType)
+		j.after = after
+	}
 	j.stopCh.Init()
 	return
 }
@@ -279,7 +291,7 @@ func (j *jogger) runBck(bck *cmn.Bck) (aborted bool, err error) {
 		Mi:       j.mi,
 		CTs:      j.opts.CTs,
 		Callback: j.jog,
-		Sorted:   false,
+		Sorted:   j.opts.Sorted,
 	}
 	opts.Bck.Copy(bck)
 
@@ -314,6 +326,11 @@ func (j *jogger) jog(fqn string, de fs.DirEntry) error {
 			return nil
 		}
 	}
+	if j.after != "" {
+		if skip, err := j.visited(fqn, de); skip {
+			return err
+		}
+	}
 	if de.IsDir() {
 		return nil
 	}
@@ -355,6 +372,41 @@ func (j *jogger) jog(fqn string, de fs.DirEntry) error {
 	return nil
 }
 
+// (sorted traversal) skip objects and entire virtual directories visited prior to j.after
+func (j *jogger) visited(fqn string, de fs.DirEntry) (bool, error) {
+	if !strings.HasPrefix(fqn, j.bdir) || len(fqn) <= len(j.bdir)+1 {
+		return false, nil
+	}
+	name := fqn[len(j.bdir)+1:]
+	if de.IsDir() {
+		if cmpSorted(name, j.after) < 0 && !strings.HasPrefix(j.after, name+cos.PathSeparator) {
+			return true, filepath.SkipDir
+		}
+		return false, nil
+	}
+	return cmpSorted(name, j.after) <= 0, nil
+}
+
+// compare object names in the order of sorted traversal, whereby
+// each directory is visited in its entirety prior to its (lexicographically) greater siblings -
+// i.e., same as string comparison with '/' being the smallest character
+func cmpSorted(a, b string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		ca, cb := a[i], b[i]
+		switch {
+		case ca == cb:
+			continue
+		case ca == '/':
+			return -1
+		case cb == '/':
+			return 1
+		default:
+			return int(ca) - int(cb)
+		}
+	}
+	return len(a) - len(b)
+}
+
 func (j *jogger) visitFQN(fqn string, buf []byte) error {
 	ct, err := core.NewCTFromFQN(fqn, core.T.Bowner())
 	if err != nil {
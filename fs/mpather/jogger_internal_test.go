@@ -0,0 +1,54 @@
+// Package mpather provides per-mountpath concepts.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package mpather
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestCmpSorted(t *testing.T) {
+	tests := []struct {
+		a, b string
+		sign int
+	}{
+		{"a", "a", 0},
+		{"a", "b", -1},
+		{"b", "a", 1},
+		{"a", "ab", -1},
+		{"a/b", "a/b", 0},
+		// '/' is the smallest: a directory sorts ahead of its siblings, including those with '-' and '.' (smaller in ASCII)
+		{"a/z", "a-b", -1},
+		{"a/z", "a.b", -1},
+		{"a-b", "a/z", 1},
+		{"a/z", "a0", -1},
+		{"a/b/c", "a/b-c", -1},
+		{"a/b", "a/b/c", -1},
+		{"", "a", -1},
+	}
+	for _, test := range tests {
+		got := cmpSorted(test.a, test.b)
+		switch {
+		case test.sign == 0:
+			tassert.Errorf(t, got == 0, "cmpSorted(%q, %q): expected 0, got %d", test.a, test.b, got)
+		case test.sign < 0:
+			tassert.Errorf(t, got < 0, "cmpSorted(%q, %q): expected negative, got %d", test.a, test.b, got)
+		default:
+			tassert.Errorf(t, got > 0, "cmpSorted(%q, %q): expected positive, got %d", test.a, test.b, got)
+		}
+	}
+}
+
+// sorted traversal: each directory is visited in its entirety before its greater siblings
+func TestCmpSortedTraversal(t *testing.T) {
+	names := []string{"a0", "a.txt", "a/b/c", "a-b", "a/b-c", "a/b/d", "b", "a/b.x/y", "a"}
+	sort.Slice(names, func(i, j int) bool { return cmpSorted(names[i], names[j]) < 0 })
+
+	expected := []string{"a", "a/b/c", "a/b/d", "a/b-c", "a/b.x/y", "a-b", "a.txt", "a0", "b"}
+	tassert.Fatalf(t, strings.Join(names, ",") == strings.Join(expected, ","), "expected %v, got %v", expected, names)
+}
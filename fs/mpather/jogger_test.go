@@ -11,6 +11,7 @@ import (
 	"math/rand"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -62,6 +63,64 @@ func TestJoggerGroup(t *testing.T) {
 	tassert.CheckFatal(t, err)
 }
 
+// sorted traversal: resume each mountpath after its (previously) visited middle object
+func TestJoggerGroupStartAfter(t *testing.T) {
+	var (
+		desc = tools.ObjectsDesc{
+			CTs: []tools.ContentTypeDesc{
+				{Type: fs.ObjectType, ContentCnt: 500},
+			},
+			MountpathsCnt: 5,
+			ObjectSize:    cos.KiB,
+		}
+		out     = tools.PrepareObjects(t, desc)
+		mu      sync.Mutex
+		visited = make(map[string][]string, desc.MountpathsCnt)
+	)
+	defer os.RemoveAll(out.Dir)
+
+	run := func(startAfter map[string]string) {
+		clear(visited)
+		opts := &mpather.JgroupOpts{
+			Bck: out.Bck,
+			CTs: []string{fs.ObjectType},
+			VisitObj: func(lom *core.LOM, _ []byte) error {
+				mu.Lock()
+				mpath := lom.Mountpath().Path
+				visited[mpath] = append(visited[mpath], lom.ObjName)
+				mu.Unlock()
+				return nil
+			},
+			Sorted:     true,
+			StartAfter: startAfter,
+		}
+		jg := mpather.NewJoggerGroup(opts, cmn.GCO.Get(), "")
+		jg.Run()
+		<-jg.ListenFinished()
+		tassert.CheckFatal(t, jg.Stop())
+	}
+
+	run(nil)
+	var (
+		all        = make(map[string][]string, len(visited))
+		startAfter = make(map[string]string, len(visited))
+	)
+	for mpath, names := range visited {
+		all[mpath] = names
+		startAfter[mpath] = names[len(names)/2]
+	}
+
+	run(startAfter)
+	for mpath, names := range all {
+		expected := names[len(names)/2+1:]
+		tassert.Fatalf(t, len(visited[mpath]) == len(expected), "%s: expected %d objects, visited %d",
+			mpath, len(expected), len(visited[mpath]))
+		for i, name := range visited[mpath] {
+			tassert.Errorf(t, name == expected[i], "%s: expected %q, got %q", mpath, expected[i], name)
+		}
+	}
+}
+
 func TestJoggerGroupParallel(t *testing.T) {
 	var (
 		parallelOptions = []int{2, 8, 24}
@@ -348,7 +348,7 @@ func (j *clnJ) jogBck() (size int64, err error) {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
 		Bck:      j.bck,
-		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType},
+		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType, fs.TrashType},
 		Callback: j.walk,
 		Sorted:   false,
 	}
@@ -363,13 +363,34 @@ func (j *clnJ) jogBck() (size int64, err error) {
 func (j *clnJ) visitCT(parsedFQN *fs.ParsedFQN, fqn string) {
 	switch parsedFQN.ContentType {
 	case fs.WorkfileType:
-		_, base := filepath.Split(fqn)
+		dir, base := filepath.Split(fqn)
+		if ttl, owner, ok := fs.NamedWorkTTL(base); ok {
+			// named workfiles: remove if not updated (or the owner, if exists, not updated) for longer than ttl
+			finfo, err := os.Lstat(filepath.Join(dir, owner))
+			if err != nil && owner != base {
+				finfo, err = os.Lstat(fqn)
+			}
+			if err == nil && finfo.ModTime().UnixNano()+ttl.Nanoseconds() < j.now {
+				j.oldWork = append(j.oldWork, fqn)
+			}
+			return
+		}
 		contentResolver := fs.CSM.Resolver(fs.WorkfileType)
 		_, old, ok := contentResolver.ParseUniqueFQN(base)
 		// workfiles: remove old or do nothing
 		if ok && old {
 			j.oldWork = append(j.oldWork, fqn)
 		}
+	case fs.TrashType:
+		// soft-deleted objects: remove expired (or all, when soft delete is disabled)
+		ttl := j.config.Space.TrashTTL.D()
+		if ttl > 0 {
+			finfo, err := os.Lstat(fqn)
+			if err != nil || finfo.ModTime().UnixNano()+ttl.Nanoseconds() > j.now {
+				return
+			}
+		}
+		j.oldWork = append(j.oldWork, fqn)
 	case fs.ECSliceType:
 		// EC slices:
 		// - EC enabled: remove only slices with missing metafiles
@@ -216,6 +216,16 @@ func (j *lruJ) run(providers []string) {
 		nlog.Infof("%s: used cap below threshold, nothing to do", j)
 		return
 	}
+	// trash first (soft-deleted objects, oldest first)
+	if n, size := purgeTrash(j.mi, 0, j.totalSize); size > 0 {
+		nlog.Infof("%s: removed %d trashed object%s (%s)", j, n, cos.Plural(int(n)), cos.ToSizeIEC(size, 2))
+		if err = j.evictSize(); err != nil {
+			goto ex
+		}
+		if j.totalSize < minEvictThresh {
+			return
+		}
+	}
 	if len(j.ini.Buckets) != 0 {
 		nlog.Infof("%s: freeing-up %s", j, cos.ToSizeIEC(j.totalSize, 2))
 		err = j.jogBcks(j.ini.Buckets, j.ini.Force)
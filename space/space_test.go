@@ -254,6 +254,57 @@ var _ = Describe("space evict/cleanup tests", func() {
 			})
 		})
 
+		Describe("trash", func() {
+			var trashPath string
+			BeforeEach(func() {
+				bck := cmn.Bck{Name: bucketName, Provider: apc.AIS, Ns: cmn.NsGlobal}
+				trashPath = fs.GetAvail()[basePath].MakePathCT(&bck, fs.TrashType)
+				cos.CreateDir(trashPath)
+			})
+			trashFile := func(name string, age time.Duration) string {
+				fqn := path.Join(trashPath, name)
+				saveRandomFile(fqn, blockSize)
+				deleted := time.Now().Add(-age)
+				Expect(os.Chtimes(fqn, deleted, deleted)).NotTo(HaveOccurred())
+				return fqn
+			}
+
+			It("should remove expired trash only", func() {
+				expired := trashFile("expired", 2*time.Hour)
+				recent := trashFile("recent", time.Minute)
+
+				n, size := space.PurgeTrash(time.Hour)
+				Expect(n).To(BeEquivalentTo(1))
+				Expect(size).To(BeEquivalentTo(blockSize))
+				Expect(expired).NotTo(BeAnExistingFile())
+				Expect(recent).To(BeARegularFile())
+			})
+
+			It("should do nothing when soft delete is disabled", func() {
+				expired := trashFile("expired", 2*time.Hour)
+				n, _ := space.PurgeTrash(0)
+				Expect(n).To(BeZero())
+				Expect(expired).To(BeARegularFile())
+			})
+
+			It("should remove expired trash during storage cleanup", func() {
+				config := cmn.GCO.BeginUpdate()
+				config.Space.TrashTTL = cos.Duration(time.Hour)
+				cmn.GCO.CommitUpdate(config)
+				defer func() {
+					config := cmn.GCO.BeginUpdate()
+					config.Space.TrashTTL = 0
+					cmn.GCO.CommitUpdate(config)
+				}()
+				expired := trashFile("expired", 2*time.Hour)
+				recent := trashFile("recent", time.Minute)
+
+				space.RunCleanup(newInitStoreCln())
+				Expect(expired).NotTo(BeAnExistingFile())
+				Expect(recent).To(BeARegularFile())
+			})
+		})
+
 		Describe("cleanup 'deleted'", func() {
 			var ini *space.IniCln
 			BeforeEach(func() {
@@ -380,6 +431,7 @@ func createAndAddMountpath(path string) {
 
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.TrashType, &fs.TrashContentResolver{}, true)
 }
 
 func getRandomFileName(fileCounter int) string {
@@ -0,0 +1,126 @@
+// Package space provides storage cleanup and eviction functionality (the latter based on the
+// least recently used cache replacement). It also serves as a built-in garbage-collection
+// mechanism for orphaned workfiles.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package space
+
+import (
+	"os"
+	"sort"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
+)
+
+// Trash: soft-deleted objects (see config.Space.TrashTTL and core.LOM.MoveToTrash)
+// - expired trash is removed periodically (see PurgeTrash) and by storage cleanup;
+// - under space pressure, trash is removed (oldest first, expired or not) prior to
+//   LRU-evicting objects (see lruJ.run) and prior to failing writes (see FreeTrash)
+
+type (
+	trashEnt struct {
+		fqn   string
+		mtime int64 // deletion time
+		size  int64
+	}
+	trashJ struct {
+		mi   *fs.Mountpath
+		ents []trashEnt
+	}
+)
+
+// remove expired trash from all available mountpaths
+// (is called periodically - see target housekeeping)
+func PurgeTrash(ttl time.Duration) (n, size int64) {
+	if ttl <= 0 {
+		return
+	}
+	expired := time.Now().UnixNano() - ttl.Nanoseconds()
+	for _, mi := range fs.GetAvail() {
+		cnt, sz := purgeTrash(mi, expired, 0)
+		n += cnt
+		size += sz
+	}
+	return
+}
+
+// out of space: free up the space taken by trash (expired or not, oldest first)
+// on each mountpath that's above high watermark; returns the total freed size
+func FreeTrash(config *cmn.Config) (size int64) {
+	for _, mi := range fs.GetAvail() {
+		need := overWM(mi, config.Space.HighWM, config.Space.LowWM)
+		if need <= 0 {
+			continue
+		}
+		_, sz := purgeTrash(mi, 0, need)
+		size += sz
+	}
+	return
+}
+
+// bytes to free up when used capacity exceeds 'wm' (to bring it down to 'lwm')
+func overWM(mi *fs.Mountpath, wm, lwm int64) int64 {
+	blocks, bavail, bsize, err := ios.GetFSStats(mi.Path)
+	if err != nil || blocks == 0 {
+		return 0
+	}
+	used := blocks - bavail
+	if used*100/blocks < uint64(wm) {
+		return 0
+	}
+	lwmBlocks := blocks * uint64(lwm) / 100
+	if used <= lwmBlocks {
+		return 0
+	}
+	return int64(used-lwmBlocks) * bsize
+}
+
+// remove trash that was deleted before 'expired' and, if need be, keep removing
+// (oldest first) until the total removed size is at least 'need' bytes
+func purgeTrash(mi *fs.Mountpath, expired, need int64) (n, size int64) {
+	j := &trashJ{mi: mi}
+	opts := &fs.WalkOpts{
+		Mi:       mi,
+		Bck:      cmn.Bck{Provider: apc.AIS, Ns: cmn.NsGlobal},
+		CTs:      []string{fs.TrashType},
+		Callback: j.walk,
+	}
+	if err := fs.Walk(opts); err != nil {
+		nlog.Warningln("failed to walk", mi.String(), "trash:", err)
+	}
+	if len(j.ents) == 0 {
+		return
+	}
+	sort.Slice(j.ents, func(i, k int) bool { return j.ents[i].mtime < j.ents[k].mtime })
+	for _, ent := range j.ents {
+		if ent.mtime >= expired && size >= need {
+			break
+		}
+		if err := cos.RemoveFile(ent.fqn); err != nil {
+			nlog.Warningln("failed to remove trash:", err)
+			continue
+		}
+		n++
+		size += ent.size
+	}
+	return
+}
+
+func (j *trashJ) walk(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	finfo, err := os.Lstat(fqn)
+	if err != nil {
+		return nil
+	}
+	j.ents = append(j.ents, trashEnt{fqn: fqn, mtime: finfo.ModTime().UnixNano(), size: finfo.Size()})
+	return nil
+}
@@ -51,6 +51,7 @@ type (
 		Callback     ObjSentCB     // typical usage: to free SGLs, close files, etc.
 		Config       *cmn.Config   // (to optimize-out GCO.Get())
 		Compression  string        // see CompressAlways, etc. enum
+		CompressLvl  string        // see CompressLevelFast, etc. enum (empty: fast)
 		SenderID     string        // e.g., xaction ID (optional)
 		IdleTeardown time.Duration // when exceeded, causes PUT to terminate (and to renew upon the very next send)
 		SizePDU      int32         // NOTE: 0(zero): no PDUs; must be below maxSizePDU; unknown size _requires_ PDUs
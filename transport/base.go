@@ -39,6 +39,8 @@ const (
 
 const maxInReadRetries = 64 // Tx: lz4 stream read; Rx: partial object header
 
+const lz4HCDepth = 256 // apc.CompressLevelHigh: lz4 HC search depth (cf. LZ4HC default level 9)
+
 // termination: reasons
 const (
 	reasonError   = "error"
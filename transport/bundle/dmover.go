@@ -40,6 +40,7 @@ type (
 		xctn        core.Xact
 		config      *cmn.Config
 		compression string // enum { apc.CompressNever, ... }
+		compressLvl string // enum { apc.CompressLevelFast, ... }
 		multiplier  int
 		owt         cmn.OWT
 		stage       struct {
@@ -55,6 +56,7 @@ type (
 		RecvAck     transport.RecvObj
 		Config      *cmn.Config
 		Compression string
+		CompressLvl string // (when compressing)
 		Multiplier  int
 		SizePDU     int32
 		MaxHdrSize  int32
@@ -82,6 +84,10 @@ func NewDataMover(trname string, recvCB transport.RecvObj, owt cmn.OWT, extra Ex
 	default:
 		return nil, fmt.Errorf("invalid compression %q", extra.Compression)
 	}
+	if !apc.IsValidCompressionLevel(extra.CompressLvl) {
+		return nil, fmt.Errorf("invalid compression level %q", extra.CompressLvl)
+	}
+	dm.compressLvl = extra.CompressLvl
 	dm.data.trname, dm.data.recv = trname, recvCB
 	if dm.data.net == "" {
 		dm.data.net = cmn.NetIntraData
@@ -127,6 +133,7 @@ func (dm *DataMover) Open() {
 		Trname: dm.data.trname,
 		Extra: &transport.Extra{
 			Compression: dm.compression,
+			CompressLvl: dm.compressLvl,
 			Config:      dm.config,
 			SizePDU:     dm.sizePDU,
 			MaxHdrSize:  dm.maxHdrSize,
@@ -112,8 +112,13 @@ func RxAnyStream(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	// compression
+	// the sender selects the codec (and level) - the receiver's own configuration does not matter
 	if compressionType := r.Header.Get(apc.HdrCompress); compressionType != "" {
-		debug.Assert(compressionType == apc.LZ4Compression)
+		if compressionType != apc.LZ4Compression {
+			err = fmt.Errorf("%s: unsupported compression %q (expecting %q)", trname, compressionType, apc.LZ4Compression)
+			cmn.WriteErr(w, r, err, http.StatusBadRequest)
+			return
+		}
 		lz4Reader = lz4.NewReader(r.Body)
 		reader = lz4Reader
 	}
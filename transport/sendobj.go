@@ -10,6 +10,7 @@ import (
 	"io"
 	"runtime"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -33,6 +34,7 @@ type (
 		zw            *lz4.Writer // orig reader => zw
 		sgl           *memsys.SGL // zw => bb => network
 		blockMaxSize  int         // *uncompressed* block max size
+		level         int         // lz4 compression level (0: fast; otherwise, HC search depth)
 		frameChecksum bool        // true: checksum lz4 frames
 	}
 	sendoff struct {
@@ -88,6 +90,9 @@ func (s *Stream) initCompression(extra *Extra) {
 	s.lz4s.s = s
 	s.lz4s.blockMaxSize = int(extra.Config.Transport.LZ4BlockMaxSize)
 	s.lz4s.frameChecksum = extra.Config.Transport.LZ4FrameChecksum
+	if extra.CompressLvl == apc.CompressLevelHigh {
+		s.lz4s.level = lz4HCDepth
+	}
 	if s.lz4s.blockMaxSize >= memsys.MaxPageSlabSize {
 		s.lz4s.sgl = g.mm.NewSGL(memsys.MaxPageSlabSize, memsys.MaxPageSlabSize)
 	} else {
@@ -171,6 +176,7 @@ func (s *Stream) doRequest() error {
 	s.lz4s.zw.Header.BlockChecksum = false
 	s.lz4s.zw.Header.NoChecksum = !s.lz4s.frameChecksum
 	s.lz4s.zw.Header.BlockMaxSize = s.lz4s.blockMaxSize
+	s.lz4s.zw.Header.CompressionLevel = s.lz4s.level
 	return s.do(&s.lz4s)
 }
 
@@ -0,0 +1,59 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/mono"
+)
+
+// token bucket to limit aggregate bandwidth (bytes per second) of concurrent senders;
+// burst: one second worth of tokens; a large object may take the bucket into debt
+// (that subsequent callers then wait out)
+type bwlim struct {
+	last   int64   // mono time of the last refill
+	tokens float64 // available bytes (negative: debt)
+	rate   int64   // bytes per second; zero: unlimited
+	mu     sync.Mutex
+}
+
+func (b *bwlim) set(rate int64) {
+	b.mu.Lock()
+	if b.rate <= 0 {
+		b.tokens = float64(rate)
+		b.last = mono.NanoTime()
+	} else {
+		b.refill() // (at the current rate)
+		b.tokens = min(b.tokens, float64(rate))
+	}
+	b.rate = rate
+	b.mu.Unlock()
+}
+
+// reserve `n` bytes and return the time to wait before sending them
+func (b *bwlim) reserve(n int64) (wait time.Duration) {
+	b.mu.Lock()
+	if b.rate > 0 {
+		rate := float64(b.rate)
+		b.refill()
+		b.tokens -= float64(n)
+		if b.tokens < 0 {
+			wait = time.Duration(-b.tokens / rate * float64(time.Second))
+		}
+	}
+	b.mu.Unlock()
+	return wait
+}
+
+// (under lock)
+func (b *bwlim) refill() {
+	now := mono.NanoTime()
+	rate := float64(b.rate)
+	b.tokens = min(b.tokens+float64(now-b.last)*rate/float64(time.Second), rate)
+	b.last = now
+}
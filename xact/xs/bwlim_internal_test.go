@@ -0,0 +1,57 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestBwlim(t *testing.T) {
+	const rate = 1000 // bytes per second
+
+	// (tolerating tokens refilled in between calls)
+	near := func(wait, expected time.Duration) bool {
+		return wait <= expected && wait > expected-50*time.Millisecond
+	}
+
+	var b bwlim
+	tassert.Errorf(t, b.reserve(1<<30) == 0, "expected no wait when unlimited")
+
+	// burst: one second worth of tokens
+	b.set(rate)
+	tassert.Errorf(t, b.reserve(rate/2) == 0, "expected no wait within burst")
+
+	// into debt: 1.5s worth requested with only 0.5s worth available
+	wait := b.reserve(rate)
+	tassert.Errorf(t, near(wait, 500*time.Millisecond), "expected ~500ms wait, got %v", wait)
+
+	// subsequent callers wait out the debt
+	wait = b.reserve(rate / 10)
+	tassert.Errorf(t, near(wait, 600*time.Millisecond), "expected ~600ms wait, got %v", wait)
+
+	// refill
+	time.Sleep(700 * time.Millisecond)
+	wait = b.reserve(rate / 10)
+	tassert.Errorf(t, near(wait, 100*time.Millisecond) || wait == 0, "expected at most ~100ms wait, got %v", wait)
+
+	// lowering the rate caps available tokens (and keeps the debt, if any)
+	b.set(rate)
+	time.Sleep(1100 * time.Millisecond) // (full bucket)
+	b.set(rate / 10)
+	wait = b.reserve(rate / 5)
+	tassert.Errorf(t, near(wait, time.Second), "expected ~1s wait, got %v", wait)
+
+	// zero: unlimited
+	b.set(0)
+	tassert.Errorf(t, b.reserve(1<<30) == 0, "expected no wait after removing the limit")
+
+	// limit again: full burst
+	b.set(rate)
+	tassert.Errorf(t, b.reserve(rate) == 0, "expected no wait within burst")
+}
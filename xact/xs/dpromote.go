@@ -128,6 +128,7 @@ func (r *XactDirPromote) walk(fqn string, de fs.DirEntry) error {
 			ObjName:      objName,
 			OverwriteDst: args.OverwriteDst,
 			DeleteSrc:    args.DeleteSrc,
+			ComputeCksum: args.ComputeCksum,
 		},
 	}
 	// TODO: continue-on-error (unify w/ x-archive)
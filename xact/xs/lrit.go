@@ -6,6 +6,8 @@
 package xs
 
 import (
+	"sync"
+
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -48,6 +50,9 @@ type (
 		pt     *cos.ParsedTemplate
 		prefix string
 		lrp    int // { lrpList, ... } enum
+		// (optional) parallelism: when non-nil, work items are executed by so many workers - see workers()
+		workCh chan string
+		nwork  int
 	}
 )
 
@@ -107,7 +112,22 @@ pref:
 	return nil
 }
 
+// must be called after init() and prior to run(); the iterator is then good for a single run
+func (r *lriterator) workers(n int) {
+	if n > 1 {
+		r.workCh, r.nwork = make(chan string, n), n
+	}
+}
+
 func (r *lriterator) run(wi lrwi, smap *meta.Smap) (err error) {
+	var wg *sync.WaitGroup
+	if r.workCh != nil {
+		wg = &sync.WaitGroup{}
+		wg.Add(r.nwork)
+		for range r.nwork {
+			go r.work(wi, wg)
+		}
+	}
 	switch r.lrp {
 	case lrpList:
 		err = r._list(wi, smap)
@@ -116,9 +136,29 @@ func (r *lriterator) run(wi lrwi, smap *meta.Smap) (err error) {
 	case lrpPrefix:
 		err = r._prefix(wi, smap)
 	}
+	if wg != nil {
+		close(r.workCh)
+		wg.Wait()
+	}
 	return err
 }
 
+func (r *lriterator) work(wi lrwi, wg *sync.WaitGroup) {
+	for objName := range r.workCh {
+		if r.done() {
+			continue // drain
+		}
+		lom := core.AllocLOM(objName)
+		if err := lom.InitBck(r.bck.Bucket()); err != nil {
+			nlog.Errorln(err)
+		} else {
+			wi.do(lom, r)
+		}
+		core.FreeLOM(lom)
+	}
+	wg.Done()
+}
+
 func (r *lriterator) done() bool { return r.parent.IsAborted() || r.parent.Finished() }
 
 func (r *lriterator) _list(wi lrwi, smap *meta.Smap) error {
@@ -227,6 +267,10 @@ func (r *lriterator) do(lom *core.LOM, wi lrwi, smap *meta.Smap) error {
 			return nil
 		}
 	}
+	if r.workCh != nil {
+		r.workCh <- lom.ObjName // (ditto)
+		return nil
+	}
 	// NOTE: lom is alloc-ed prior to the call and freed upon return
 	wi.do(lom, r)
 	return nil
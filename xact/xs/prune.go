@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -24,6 +25,8 @@ import (
 
 // When synchronizing source => destination:
 // remove destination objects that are not present at the source (any longer)
+// - scope: destination objects that match the prefix and, optionally, `match` (e.g. CopyBckMsg.Regex)
+// - dry-run: count but do not remove
 // Limitations (TODO):
 // - not supporting apc.ListRange
 // - use probabilistic filtering to skip received and locally copied obj-s (see `reb.FilterAdd` et al)
@@ -32,11 +35,14 @@ type prune struct {
 	parent         core.Xact
 	bckFrom, bckTo *meta.Bck
 	smap           *meta.Smap
+	match          func(*core.LOM) bool // (optional)
 	prefix         string
 	// run
 	joggers *mpather.Jgroup
 	filter  *prob.Filter
+	cnt     atomic.Int64 // removed (or, if dry-run, to be removed)
 	same    bool
+	dryRun  bool
 }
 
 func (rp *prune) init(config *cmn.Config) {
@@ -93,6 +99,9 @@ func (rp *prune) do(dst *core.LOM, _ []byte) error {
 		_, local, err := dst.HrwTarget(rp.smap)
 		debug.Assertf(local, "local %t, err: %v", local, err)
 	})
+	if rp.match != nil && !rp.match(dst) {
+		return nil
+	}
 	// construct src lom
 	var src *core.LOM
 	if rp.same {
@@ -138,6 +147,10 @@ func (rp *prune) do(dst *core.LOM, _ []byte) error {
 	}
 
 	// source does not exist: try to remove the destination (NOTE best effort)
+	if rp.dryRun {
+		rp.cnt.Inc()
+		return nil
+	}
 	if !dst.TryLock(true) {
 		return nil
 	}
@@ -148,6 +161,7 @@ func (rp *prune) do(dst *core.LOM, _ []byte) error {
 	dst.Unlock(true)
 
 	if err == nil {
+		rp.cnt.Inc()
 		if cmn.Rom.FastV(5, cos.SmoduleXs) {
 			nlog.Infoln(rp.parent.Name(), dst.Cname())
 		}
@@ -8,6 +8,9 @@ package xs
 import (
 	"fmt"
 	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"sync"
 	"time"
 
@@ -23,6 +26,7 @@ import (
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/mpather"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/sys"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/transport/bundle"
 	"github.com/NVIDIA/aistore/xact"
@@ -36,6 +40,9 @@ type (
 		kind  string
 		phase string // (see "transition")
 		args  *xreg.TCBArgs
+		re    *regexp.Regexp // (optional) CopyBckMsg.Regex
+		cmk   string         // (optional) CopyBckMsg.CustomFilter: key
+		cmv   string         // and value
 		owt   cmn.OWT
 	}
 	XactTCB struct {
@@ -44,15 +51,41 @@ type (
 		rxlast atomic.Int64 // finishing
 		xact.BckJog
 		prune    prune
+		bw       bwlim // (optional) CopyBckMsg.MaxBW
 		nam, str string
 		wg       sync.WaitGroup // starting up
 		refc     atomic.Int32   // finishing
+		// progress (see CopyBckMsg.PreCount)
+		total     atomic.Int64 // pre-counted source objects (this target)
+		processed atomic.Int64 // visited so far
+		// dry-run: (objects, bytes) that would be copied (see CopyBckMsg.DryRun)
+		dryrun struct {
+			n    atomic.Int64
+			size atomic.Int64
+		}
+		// (plain copy) persistent progress checkpoint - see tcbckpt.go
+		ckpts tcbCkpts
+		// quiescence timeout: cause (see qcb)
+		qui struct {
+			err   error         // first of the accumulated errors
+			since time.Duration // Rx idle
+			nerr  int           // number of errors
+			refc  int32         // remaining senders
+		}
+	}
+	// extended x-tcb statistics (only when pre-counting, synchronizing, or dry-running)
+	ExtTCBStats struct {
+		Total      int64 `json:"tcb.total.n,string,omitempty"`
+		Processed  int64 `json:"tcb.processed.n,string,omitempty"`
+		Pruned     int64 `json:"tcb.pruned.n,string,omitempty"`     // destination objects removed (dry-run: to be removed)
+		DryRunObjs int64 `json:"tcb.dry-run.n,string,omitempty"`    // dry-run: objects that would be copied
+		DryRunSize int64 `json:"tcb.dry-run.size,string,omitempty"` // dry-run: total bytes that would be copied
 	}
 )
 
 const OpcTxnDone = 27182
 
-const etlBucketParallelCnt = 2
+const etlBucketParallelCnt = 2 // min (and the default when auto-tuning is not possible)
 
 // interface guard
 var (
@@ -80,9 +113,21 @@ func (p *tcbFactory) Start() error {
 	if p.kind == apc.ActETLBck {
 		p.owt = cmn.OwtTransform
 	}
+	if p.args.Msg.Regex != "" {
+		if p.re, err = regexp.Compile(p.args.Msg.Regex); err != nil {
+			return err // (validated by proxy)
+		}
+	}
+	if p.args.Msg.CustomFilter != "" {
+		if p.cmk, p.cmv, err = p.args.Msg.ParseCustomFilter(); err != nil {
+			return err // ditto
+		}
+	}
 
 	smap := core.T.Sowner().Get()
-	p.xctn = newTCB(p, slab, config, smap)
+	if p.xctn, err = newTCB(p, slab, config, smap); err != nil {
+		return err
+	}
 
 	// refcount OpcTxnDone; this target must ve active (ref: ignoreMaintenance)
 	if err := core.InMaintOrDecomm(smap, core.T.Snode(), p.xctn); err != nil {
@@ -108,9 +153,14 @@ func (p *tcbFactory) newDM(config *cmn.Config, uuid string, sizePDU int32) error
 		RecvAck:     nil, // no ACKs
 		Config:      config,
 		Compression: config.TCB.Compression,
+		CompressLvl: config.TCB.CompressionLevel,
 		Multiplier:  config.TCB.SbundleMult,
 		SizePDU:     sizePDU,
 	}
+	// per-job override (the receivers decode regardless - see transport/recv.go)
+	if lvl := p.args.Msg.CompressionLevel; lvl != "" {
+		dmExtra.Compression, dmExtra.CompressLvl = apc.CompressAlways, lvl
+	}
 	// in re cmn.OwtPut: see comment inside _recv()
 	dm, err := bundle.NewDataMover(trname+"-"+uuid, p.xctn.recv, p.owt, dmExtra)
 	if err != nil {
@@ -157,16 +207,25 @@ func (r *XactTCB) TxnAbort(err error) {
 	r.Base.Finish()
 }
 
-func newTCB(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, smap *meta.Smap) (r *XactTCB) {
+func newTCB(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, smap *meta.Smap) (r *XactTCB, err error) {
 	r = &XactTCB{p: p}
 
 	s1, s2 := r._str(), r.p.args.BckFrom.String()
 	r.nam = r.Base.Name() + " <= " + s2 + s1
 	r.str = r.Base.String() + " <= " + s2 + s1
 
-	var parallel int
+	var (
+		parallel   int
+		startAfter map[string]string
+	)
 	if p.kind == apc.ActETLBck {
-		parallel = etlBucketParallelCnt // TODO: optimize with respect to disk bw and transforming computation
+		parallel = etlParallel(p.args.Msg, config)
+	} else if !p.args.Msg.DryRun && (p.args.Msg.Checkpoint || p.args.Msg.Resume != "") {
+		r.ckpts = make(tcbCkpts, 4)
+		startAfter, err = r.ckpts.init(p.UUID(), p.args.Msg.Resume, p.args.BckFrom, p.args.BckTo)
+		if err != nil {
+			return nil, err
+		}
 	}
 	mpopts := &mpather.JgroupOpts{
 		CTs:      []string{fs.ObjectType},
@@ -176,10 +235,17 @@ func newTCB(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, smap *meta.Sma
 		Parallel: parallel,
 		DoLoad:   mpather.Load,
 		Throttle: true, // always trottling
+		// checkpointing requires sorted traversal
+		Sorted:     r.ckpts != nil,
+		StartAfter: startAfter,
 	}
 	mpopts.Bck.Copy(p.args.BckFrom.Bucket())
 	r.BckJog.Init(p.UUID(), p.kind, p.args.BckTo, mpopts, config)
 
+	if p.args.Msg.MaxBW > 0 {
+		r.bw.set(p.args.Msg.MaxBW)
+	}
+
 	if p.args.Msg.Sync {
 		debug.Assert(p.args.Msg.Prepend == "", p.args.Msg.Prepend) // validated (cli, P)
 		{
@@ -188,12 +254,50 @@ func newTCB(p *tcbFactory, slab *memsys.Slab, config *cmn.Config, smap *meta.Sma
 			r.prune.bckFrom = p.args.BckFrom
 			r.prune.bckTo = p.args.BckTo
 			r.prune.prefix = p.args.Msg.Prefix
+			r.prune.match = r.match
+			r.prune.dryRun = p.args.Msg.DryRun
 		}
 		r.prune.init(config)
 	}
 	return
 }
 
+// number of concurrent transformations per mountpath, in the order of precedence:
+// - TCBMsg (e.g., 'ais etl bucket --num-workers')
+// - config.TCB.Parallelism
+// - auto: CPUs per mountpath, scaled down as the (max) mountpath utilization approaches high watermark
+func etlParallel(msg *apc.TCBMsg, config *cmn.Config) int {
+	if msg.Transform.Parallelism > 0 {
+		return msg.Transform.Parallelism
+	}
+	if config.TCB.Parallelism > 0 {
+		return config.TCB.Parallelism
+	}
+	avail := fs.GetAvail()
+	if len(avail) == 0 {
+		return etlBucketParallelCnt
+	}
+	var (
+		umax int64
+		hi   = max(sys.NumCPU()/len(avail), etlBucketParallelCnt)
+		lwm  = config.Disk.DiskUtilLowWM
+		hwm  = config.Disk.DiskUtilHighWM
+	)
+	hi = min(hi, apc.MaxTCBParallelism)
+	for _, mi := range avail {
+		umax = max(umax, fs.GetMpathUtil(mi.Path))
+	}
+	switch {
+	case umax <= lwm:
+		return hi
+	case umax >= hwm:
+		return etlBucketParallelCnt
+	default:
+		n := hi - int(int64(hi-etlBucketParallelCnt)*(umax-lwm)/(hwm-lwm))
+		return max(n, etlBucketParallelCnt)
+	}
+}
+
 func (r *XactTCB) WaitRunning() { r.wg.Wait() }
 
 func (r *XactTCB) Run(wg *sync.WaitGroup) {
@@ -205,13 +309,25 @@ func (r *XactTCB) Run(wg *sync.WaitGroup) {
 
 	r.wg.Done()
 
-	r.BckJog.Run()
-	if r.p.args.Msg.Sync {
-		r.prune.run() // the 2nd jgroup
+	if r.p.args.Msg.PreCount {
+		r.precount()
 	}
+	r.BckJog.Run()
 	nlog.Infoln(r.Name())
 
 	err := r.BckJog.Wait()
+	aborted := err != nil || r.IsAborted()
+
+	// post-copy reconciliation: remove destination objects that do not exist at the source
+	// (runs while the data mover quiesces - see prune.wait below);
+	// skip when aborted or failed - the source may not have been fully traversed
+	prune := r.p.args.Msg.Sync && !aborted
+	if prune {
+		r.prune.run() // the 2nd jgroup
+	} else if r.p.args.Msg.Sync {
+		nlog.Warningln(r.Name(), "aborted or failed - not pruning the destination")
+		r.prune.filter.Reset()
+	}
 
 	if r.dm != nil {
 		o := transport.AllocSend()
@@ -220,31 +336,39 @@ func (r *XactTCB) Run(wg *sync.WaitGroup) {
 
 		q := r.Quiesce(cmn.Rom.CplaneOperation(), r.qcb)
 		if q == core.QuiTimeout {
-			r.AddErr(fmt.Errorf("%s: %v", r, cmn.ErrQuiesceTimeout))
+			r.AddErr(r.quiErr())
 		}
 
 		// close
 		r.dm.Close(err)
 		r.dm.UnregRecv()
 	}
-	if r.p.args.Msg.Sync {
+	if r.ckpts != nil {
+		r.ckpts.fini(aborted) // (all copies are done, keep checkpoints to resume)
+	}
+	if prune {
 		r.prune.wait()
 	}
 	r.Finish()
 }
 
 func (r *XactTCB) qcb(tot time.Duration) core.QuiRes {
-	// TODO -- FIXME =======================
-	if cnt := r.ErrCnt(); cnt > 0 {
-		// to break quiescence - the waiter will look at r.Err() first anyway
+	if cnt, err := r.JoinErr(); cnt > 0 {
+		// to break quiescence - record the cause (the waiter will look at r.Err() first anyway)
+		r.qui.nerr = cnt
+		if errs, ok := err.(interface{ Unwrap() []error }); ok && len(errs.Unwrap()) > 0 {
+			err = errs.Unwrap()[0]
+		}
+		r.qui.err = err
 		return core.QuiTimeout
 	}
 
 	since := mono.Since(r.rxlast.Load())
-	if r.refc.Load() > 0 {
+	if refc := r.refc.Load(); refc > 0 {
 		if since > cmn.Rom.MaxKeepalive() {
 			// idle on the Rx side despite having some (refc > 0) senders
 			if tot > r.BckJog.Config.Timeout.SendFile.D() {
+				r.qui.refc, r.qui.since = refc, since
 				return core.QuiTimeout
 			}
 		}
@@ -256,11 +380,105 @@ func (r *XactTCB) qcb(tot time.Duration) core.QuiRes {
 	return core.QuiInactiveCB
 }
 
+// quiescence timed out: stuck senders or accumulated (receive) errors
+func (r *XactTCB) quiErr() error {
+	if r.qui.nerr > 0 {
+		return fmt.Errorf("%s: %v due to %d error%s, first: %v",
+			r, cmn.ErrQuiesceTimeout, r.qui.nerr, cos.Plural(r.qui.nerr), r.qui.err)
+	}
+	return fmt.Errorf("%s: %v due to stuck senders (refc %d, Rx idle for %v)",
+		r, cmn.ErrQuiesceTimeout, r.qui.refc, r.qui.since)
+}
+
+// count source objects that this target is going to visit (and copy)
+// NOTE: local walk on all mountpaths, skipping copies (replicas) - compare w/ mpather jogger
+func (r *XactTCB) precount() {
+	var (
+		wg     sync.WaitGroup
+		bck    = r.p.args.BckFrom.Bucket()
+		prefix = r.p.args.Msg.Prefix
+		avail  = fs.GetAvail()
+	)
+	for _, mi := range avail {
+		wg.Add(1)
+		go func(mi *fs.Mountpath) {
+			var (
+				n         int64
+				bdir      = mi.MakePathCT(bck, fs.ObjectType)
+				objPrefix = filepath.Join(bdir, prefix)
+			)
+			cb := func(fqn string, de fs.DirEntry) error {
+				if prefix != "" {
+					if de.IsDir() {
+						if !cmn.DirHasOrIsPrefix(fqn, objPrefix) {
+							return filepath.SkipDir
+						}
+					} else if !strings.HasPrefix(fqn, objPrefix) {
+						return nil
+					}
+				}
+				if de.IsDir() {
+					return nil
+				}
+				if r.IsAborted() {
+					return cmn.NewErrAborted(r.Name(), "pre-count", nil)
+				}
+				lom := core.AllocLOM("")
+				if lom.InitFQN(fqn, bck) == nil && lom.IsHRW() && r._load(lom) && r.match(lom) {
+					n++
+				}
+				core.FreeLOM(lom)
+				return nil
+			}
+			opts := &fs.WalkOpts{Mi: mi, CTs: []string{fs.ObjectType}, Callback: cb}
+			opts.Bck.Copy(bck)
+			if err := fs.Walk(opts); err != nil && !cmn.IsErrAborted(err) {
+				nlog.Warningln(r.Name(), "pre-count:", err)
+			}
+			r.total.Add(n)
+			wg.Done()
+		}(mi)
+	}
+	wg.Wait()
+	nlog.Infoln(r.Name(), "pre-counted", r.total.Load(), "objects")
+}
+
+// (prefix, if any, is applied by mpather that does not descend into non-matching virtual directories)
+func (r *XactTCB) match(lom *core.LOM) bool {
+	if r.p.re != nil && !r.p.re.MatchString(lom.ObjName) {
+		return false
+	}
+	if r.p.cmk != "" {
+		v, ok := lom.GetCustomKey(r.p.cmk)
+		return ok && v == r.p.cmv
+	}
+	return true
+}
+
+// pre-count: custom filter requires loaded metadata (compare w/ mpather.Load)
+func (r *XactTCB) _load(lom *core.LOM) bool {
+	return r.p.cmk == "" || lom.Load(false /*cache it*/, false /*locked*/) == nil
+}
+
 func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
+	ent := r.ckpts.begin(lom)
+	if !r.match(lom) {
+		ent.end(nil)
+		return nil
+	}
 	var (
 		args   = r.p.args // TCBArgs
 		toName = args.Msg.ToName(lom.ObjName)
 	)
+	if toName == "" {
+		err = fmt.Errorf("%s: empty destination name for %s", r.Name(), lom.Cname())
+		ent.end(err)
+		r.AddErr(err, 0)
+		return nil
+	}
+	if args.Msg.PreCount {
+		r.processed.Inc()
+	}
 	if cmn.Rom.FastV(5, cos.SmoduleXs) {
 		nlog.Infoln(r.Base.Name()+":", lom.Cname(), "=>", args.BckTo.Cname(toName))
 	}
@@ -276,13 +494,24 @@ func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
 		coiParams.DryRun = args.Msg.DryRun
 		coiParams.LatestVer = args.Msg.LatestVer
 		coiParams.Sync = args.Msg.Sync
+		if ent != nil {
+			coiParams.OnSent = ent.end
+		}
+		if !args.Msg.DryRun {
+			coiParams.Throttle = r.throttle // (max bandwidth)
+		}
+	}
+	if args.Msg.Sync {
+		// exists at the source (whether copied or not) - never prune
+		r.prune.filter.Insert(cos.UnsafeB(lom.Uname()))
 	}
-	_, err = core.T.CopyObject(lom, r.dm, coiParams)
+	size, err := core.T.CopyObject(lom, r.dm, coiParams)
 	core.FreeCOI(coiParams)
 	switch {
 	case err == nil:
-		if args.Msg.Sync {
-			r.prune.filter.Insert(cos.UnsafeB(lom.Uname()))
+		if args.Msg.DryRun {
+			r.dryrun.n.Inc()
+			r.dryrun.size.Add(size)
 		}
 	case cos.IsNotExist(err, 0):
 		// do nothing
@@ -294,6 +523,22 @@ func (r *XactTCB) do(lom *core.LOM, buf []byte) (err error) {
 	return
 }
 
+// max bandwidth: wait (in abortable increments) for the tokens to become available
+// (see core.CopyParams.Throttle)
+func (r *XactTCB) throttle(size int64) {
+	for wait := r.bw.reserve(size); wait > 0 && !r.IsAborted(); {
+		sleep := min(wait, time.Second)
+		time.Sleep(sleep)
+		wait -= sleep
+	}
+}
+
+// change max bandwidth at runtime (zero: unlimited)
+func (r *XactTCB) SetMaxBW(bw int64) {
+	r.bw.set(bw)
+	nlog.Infoln(r.Name(), "max bandwidth:", cos.ToSizeIEC(bw, 0)+"/s")
+}
+
 // NOTE: strict(est) error handling: abort on any of the errors below
 func (r *XactTCB) recv(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
 	if err != nil && !cos.IsEOF(err) {
@@ -362,6 +607,15 @@ func (r *XactTCB) _str() (s string) {
 	if msg.Sync {
 		s = ", synchronize"
 	}
+	if msg.Regex != "" {
+		s += ", regex " + msg.Regex
+	}
+	if msg.CustomFilter != "" {
+		s += ", custom " + msg.CustomFilter
+	}
+	if msg.MaxBW > 0 {
+		s += ", max-bw " + cos.ToSizeIEC(msg.MaxBW, 0) + "/s"
+	}
 	return s
 }
 
@@ -379,5 +633,14 @@ func (r *XactTCB) Snap() (snap *core.Snap) {
 	snap.IdleX = r.IsIdle()
 	f, t := r.FromTo()
 	snap.SrcBck, snap.DstBck = f.Clone(), t.Clone()
+	if msg := r.p.args.Msg; msg.PreCount || msg.Sync || msg.DryRun {
+		snap.Ext = &ExtTCBStats{
+			Total:      r.total.Load(),
+			Processed:  r.processed.Load(),
+			Pruned:     r.prune.cnt.Load(),
+			DryRunObjs: r.dryrun.n.Load(),
+			DryRunSize: r.dryrun.size.Load(),
+		}
+	}
 	return
 }
@@ -0,0 +1,207 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Bucket-to-bucket copy (x-tcb): persistent progress checkpoint
+// - enabled upon request (CopyBckMsg.Checkpoint) and when resuming (CopyBckMsg.Resume)
+// - one checkpoint per mountpath (ie., per jogger), stored on the same mountpath
+//   as a workfile of the source bucket: <mpath>/<source bucket>/%wk/tcb-ckpt-<xaction ID>
+// - traversal is sorted (see mpather.JgroupOpts.Sorted), so that the checkpoint - the last
+//   copied source object - also implies all the objects that precede it
+// - advances only over the contiguous sequence of completed copies: objects sent to other
+//   targets complete when the data mover calls back (see core.CopyParams.OnSent);
+//   the first failed copy stops the respective mountpath's checkpoint from advancing any further
+// - saved periodically and, again, when aborted or failed; removed upon successful completion
+// - resuming loads the previous job's checkpoints and skips already copied objects
+//   (see mpather.JgroupOpts.StartAfter)
+// - leftovers (jobs that were never resumed) are removed by space cleanup (see fs.NamedWorkTTL)
+// Limitations:
+// - plain copies only (no ETL), given concurrent per-mountpath transformations (see etlParallel)
+
+const (
+	tcbCkptIval = 10 * time.Second
+)
+
+type (
+	// on-disk format (plain JSON)
+	tcbCkpt struct {
+		XID     string `json:"xid"`
+		BckFrom string `json:"bck_from"`
+		BckTo   string `json:"bck_to"`
+		Last    string `json:"last"`        // last copied source object (name)
+		N       int64  `json:"n,string"`    // number of copied objects (cumulative, including resumed)
+		Time    int64  `json:"time,string"` // when saved (Unix nano)
+	}
+	tcbCkptMpath struct {
+		fqn      string
+		prev     string // checkpoint to resume from (to remove upon completion)
+		inflight []*tcbCkptEnt
+		ckpt     tcbCkpt
+		saved    int64 // mono time
+		mu       sync.Mutex
+		failed   bool
+		closed   bool
+	}
+	// in-flight copy, in the jogger's (sorted) order
+	tcbCkptEnt struct {
+		ck   *tcbCkptMpath
+		name string
+		done bool
+	}
+	// mountpath => checkpoint
+	tcbCkpts map[string]*tcbCkptMpath
+)
+
+func tcbCkptFQN(mi *fs.Mountpath, bckFrom *meta.Bck, xid string) string {
+	return mi.MakePathFQN(bckFrom.Bucket(), fs.WorkfileType, fs.WorkTcbCkptPrefix+xid)
+}
+
+// returns per-mountpath names to start after (when resuming)
+func (cks tcbCkpts) init(xid, resume string, bckFrom, bckTo *meta.Bck) (startAfter map[string]string, _ error) {
+	var (
+		now    = mono.NanoTime()
+		avail  = fs.GetAvail()
+		sfrom  = bckFrom.Cname("")
+		sto    = bckTo.Cname("")
+		loaded int
+	)
+	if resume != "" {
+		startAfter = make(map[string]string, len(avail))
+	}
+	for _, mi := range avail {
+		ck := &tcbCkptMpath{
+			fqn:   tcbCkptFQN(mi, bckFrom, xid),
+			ckpt:  tcbCkpt{XID: xid, BckFrom: sfrom, BckTo: sto},
+			saved: now,
+		}
+		cks[mi.Path] = ck
+		if resume == "" {
+			continue
+		}
+		var (
+			prev tcbCkpt
+			fqn  = tcbCkptFQN(mi, bckFrom, resume)
+		)
+		if _, err := jsp.Load(fqn, &prev, jsp.Plain()); err != nil {
+			if !os.IsNotExist(err) {
+				return nil, fmt.Errorf("failed to load %s checkpoint %q: %v", resume, fqn, err)
+			}
+			continue // (nothing visited yet, or new mountpath)
+		}
+		if prev.BckFrom != sfrom || prev.BckTo != sto {
+			return nil, fmt.Errorf("cannot resume %s: checkpoint %q refers to %s => %s (expecting %s => %s)",
+				resume, fqn, prev.BckFrom, prev.BckTo, sfrom, sto)
+		}
+		ck.prev = fqn
+		ck.ckpt.Last, ck.ckpt.N = prev.Last, prev.N
+		startAfter[mi.Path] = prev.Last
+		loaded++
+	}
+	if resume != "" {
+		nlog.Infoln(xid, "resuming", resume, "from", loaded, "checkpoint"+cos.Plural(loaded))
+	}
+	return startAfter, nil
+}
+
+// is called by the jogger prior to copying (or skipping) the object; returns nil when
+// not tracking (mountpath added at runtime, or failed)
+func (cks tcbCkpts) begin(lom *core.LOM) (ent *tcbCkptEnt) {
+	ck, ok := cks[lom.Mountpath().Path]
+	if !ok {
+		return nil
+	}
+	ck.mu.Lock()
+	if !ck.failed {
+		ent = &tcbCkptEnt{ck: ck, name: lom.ObjName}
+		ck.inflight = append(ck.inflight, ent)
+	}
+	ck.mu.Unlock()
+	return ent
+}
+
+// is called upon copy completion (see core.CopyParams.OnSent), possibly out of order
+func (ent *tcbCkptEnt) end(err error) {
+	if ent == nil {
+		return
+	}
+	ck := ent.ck
+	ck.mu.Lock()
+	ent.done = true
+	switch {
+	case ck.failed:
+	case err != nil && !cos.IsNotExist(err, 0):
+		ck.failed = true // stop advancing
+		ck.inflight = nil
+	default:
+		ck.advance()
+		if !ck.closed && mono.Since(ck.saved) > tcbCkptIval {
+			ck.save()
+		}
+	}
+	ck.mu.Unlock()
+}
+
+// advance over the contiguous prefix of completed copies
+func (ck *tcbCkptMpath) advance() {
+	var i int
+	for ; i < len(ck.inflight) && ck.inflight[i].done; i++ {
+		ck.ckpt.Last = ck.inflight[i].name
+		ck.ckpt.N++
+	}
+	if i > 0 {
+		n := copy(ck.inflight, ck.inflight[i:])
+		clear(ck.inflight[n:])
+		ck.inflight = ck.inflight[:n]
+	}
+}
+
+// is called after all joggers are done and the data mover is closed;
+// keeps checkpoints of aborted and failed jobs (to resume)
+func (cks tcbCkpts) fini(aborted bool) {
+	for _, ck := range cks {
+		ck.mu.Lock()
+		ck.closed = true
+		if aborted || ck.failed {
+			if ck.ckpt.Last != "" {
+				ck.save()
+			}
+			ck.mu.Unlock()
+			continue
+		}
+		ck.mu.Unlock()
+		for _, fqn := range []string{ck.fqn, ck.prev} {
+			if fqn == "" {
+				continue
+			}
+			if err := cos.RemoveFile(fqn); err != nil {
+				nlog.Warningln("failed to remove tcb checkpoint:", err)
+			}
+		}
+	}
+}
+
+func (ck *tcbCkptMpath) save() {
+	ck.ckpt.Time = time.Now().UnixNano()
+	if err := jsp.Save(ck.fqn, &ck.ckpt, jsp.Plain(), nil); err != nil {
+		nlog.Warningln("failed to save tcb checkpoint:", err)
+	}
+	ck.saved = mono.NanoTime()
+}
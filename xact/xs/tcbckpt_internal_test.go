@@ -0,0 +1,148 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func (ck *tcbCkptMpath) testBegin(name string) *tcbCkptEnt {
+	ent := &tcbCkptEnt{ck: ck, name: name}
+	ck.inflight = append(ck.inflight, ent)
+	return ent
+}
+
+func TestTcbCkptAdvance(t *testing.T) {
+	var (
+		ck   = &tcbCkptMpath{closed: true}
+		ents = make([]*tcbCkptEnt, 0, 5)
+	)
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		ents = append(ents, ck.testBegin(name))
+	}
+	check := func(last string, n int64) {
+		t.Helper()
+		tassert.Errorf(t, ck.ckpt.Last == last && ck.ckpt.N == n, "expected (%q, %d), got (%q, %d)",
+			last, n, ck.ckpt.Last, ck.ckpt.N)
+	}
+
+	// completions out of order: advance over the contiguous prefix only
+	ents[2].end(nil)
+	check("", 0)
+	ents[0].end(nil)
+	check("a", 1)
+	ents[1].end(os.ErrNotExist) // (source object deleted in the meantime - nothing to copy)
+	check("c", 3)
+	tassert.Errorf(t, len(ck.inflight) == 2, "expected 2 in-flight, got %d", len(ck.inflight))
+
+	// failure: stop advancing
+	ents[4].end(errors.New("failed to send"))
+	ents[3].end(nil)
+	check("c", 3)
+	tassert.Errorf(t, ck.failed, "expected failed")
+}
+
+func TestTcbCkptResume(t *testing.T) {
+	out := tools.PrepareObjects(t, tools.ObjectsDesc{
+		CTs:           []tools.ContentTypeDesc{{Type: fs.ObjectType, ContentCnt: 1}},
+		MountpathsCnt: 3,
+		ObjectSize:    cos.KiB,
+	})
+	var (
+		bckFrom = meta.CloneBck(&out.Bck)
+		bckTo   = meta.NewBck("dst", apc.AIS, cmn.NsGlobal)
+		other   = meta.NewBck("other", apc.AIS, cmn.NsGlobal)
+		cks     = make(tcbCkpts, 3)
+	)
+	startAfter, err := cks.init("xid-1", "", bckFrom, bckTo)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, startAfter == nil, "expected nothing to start after, got %v", startAfter)
+
+	// copy (and complete) some objects on all mountpaths but one
+	var skipped string
+	for mpath, ck := range cks {
+		if skipped == "" {
+			skipped = mpath
+			continue
+		}
+		ck.testBegin("x/1").end(nil)
+		ck.testBegin("x/2").end(nil)
+		ck.testBegin("x/3") // in flight
+	}
+	cks.fini(true /*aborted*/)
+	for mpath, ck := range cks {
+		_, err := os.Stat(ck.fqn)
+		if mpath == skipped {
+			tassert.Errorf(t, os.IsNotExist(err), "%s: expected no checkpoint, got %v", mpath, err)
+		} else {
+			tassert.Errorf(t, err == nil, "%s: expected checkpoint, got %v", mpath, err)
+		}
+	}
+
+	// resume: wrong destination
+	_, err = make(tcbCkpts, 3).init("xid-2", "xid-1", bckFrom, other)
+	tassert.Errorf(t, err != nil, "expected error resuming into a different destination")
+
+	// resume
+	cks2 := make(tcbCkpts, 3)
+	startAfter, err = cks2.init("xid-2", "xid-1", bckFrom, bckTo)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(startAfter) == len(cks)-1, "expected %d checkpoints, got %d", len(cks)-1, len(startAfter))
+	for mpath, name := range startAfter {
+		tassert.Errorf(t, name == "x/2", "%s: expected to start after %q, got %q", mpath, "x/2", name)
+		tassert.Errorf(t, cks2[mpath].ckpt.N == 2, "%s: expected cumulative count 2, got %d", mpath, cks2[mpath].ckpt.N)
+	}
+
+	// successful completion removes both the current and the resumed-from checkpoints
+	for mpath := range startAfter {
+		cks2[mpath].testBegin("x/3").end(nil)
+	}
+	cks2.fini(false)
+	for mpath, ck := range cks2 {
+		for _, fqn := range []string{ck.fqn, cks[mpath].fqn} {
+			_, err := os.Stat(fqn)
+			tassert.Errorf(t, os.IsNotExist(err), "%s: expected %q removed, got %v", mpath, fqn, err)
+		}
+	}
+}
+
+func TestTcbCkptFailedKept(t *testing.T) {
+	out := tools.PrepareObjects(t, tools.ObjectsDesc{
+		CTs:           []tools.ContentTypeDesc{{Type: fs.ObjectType, ContentCnt: 1}},
+		MountpathsCnt: 1,
+		ObjectSize:    cos.KiB,
+	})
+	var (
+		bckFrom = meta.CloneBck(&out.Bck)
+		bckTo   = meta.NewBck("dst", apc.AIS, cmn.NsGlobal)
+		cks     = make(tcbCkpts, 1)
+	)
+	_, err := cks.init("xid-1", "", bckFrom, bckTo)
+	tassert.CheckFatal(t, err)
+	for _, ck := range cks {
+		ck.testBegin("a").end(nil)
+		ck.testBegin("b").end(errors.New("failed to send"))
+		ck.testBegin("c").end(nil)
+	}
+	// not aborted but failed to copy "b": keep the checkpoint (to resume from "a")
+	cks.fini(false)
+	startAfter, err := make(tcbCkpts, 1).init("xid-2", "xid-1", bckFrom, bckTo)
+	tassert.CheckFatal(t, err)
+	for mpath, name := range startAfter {
+		tassert.Errorf(t, name == "a", "%s: expected to start after %q, got %q", mpath, "a", name)
+	}
+	tassert.Errorf(t, len(startAfter) == 1, "expected 1 checkpoint, got %d", len(startAfter))
+}
@@ -0,0 +1,76 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Built-in transcoding data provider (see apc.CopyBckMsg.Transcode):
+// - reuses the DP plumbing (compare with core.LDP and etl.OfflineDP) to stream
+//   each source object through a (re)compressor while copying
+// - selection is by extension, consistently with apc.TCBMsg.ToName that names the destination;
+//   all other objects pass through untouched
+// - the resulting size is not known upfront (cos.ContentLengthUnknown)
+
+type TranscodeDP struct {
+	ldp  core.LDP
+	kind string
+}
+
+// interface guard
+var _ core.DP = (*TranscodeDP)(nil)
+
+func NewTranscodeDP(kind string) (*TranscodeDP, error) {
+	if kind == "" || !apc.IsValidTranscode(kind) {
+		return nil, fmt.Errorf("invalid transcode %q (expecting one of: %v)", kind, apc.SupportedTranscode)
+	}
+	return &TranscodeDP{kind: kind}, nil
+}
+
+func (dp *TranscodeDP) Reader(lom *core.LOM, latestVer, sync bool) (cos.ReadOpenCloser, cos.OAH, error) {
+	if !strings.HasSuffix(lom.ObjName, archive.ExtTar) {
+		return dp.ldp.Reader(lom, latestVer, sync) // as is
+	}
+	src, oah, err := dp.ldp.Reader(lom, latestVer, sync)
+	if err != nil {
+		return nil, nil, err
+	}
+	pr, pw := io.Pipe()
+	go tar2tgz(src, pw)
+
+	oa := &cmn.ObjAttrs{
+		Size:  cos.ContentLengthUnknown,
+		Cksum: cos.NoneCksum, // (will be computed by the destination)
+		Atime: oah.AtimeUnix(),
+	}
+	return cos.NopOpener(pr), oa, nil
+}
+
+// closing the pipe's reader (by the consumer) terminates the writer, and vice versa
+func tar2tgz(src io.ReadCloser, pw *io.PipeWriter) {
+	var (
+		buf, slab = core.T.PageMM().AllocSize(memsys.DefaultBufSize)
+		gzw       = gzip.NewWriter(pw)
+	)
+	_, err := io.CopyBuffer(gzw, src, buf)
+	if errC := gzw.Close(); err == nil {
+		err = errC
+	}
+	slab.Free(buf)
+	src.Close()
+	pw.CloseWithError(err)
+}
@@ -0,0 +1,145 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestTranscodeToName(t *testing.T) {
+	tests := []struct {
+		msg  apc.TCBMsg
+		name string
+		out  string
+	}{
+		{apc.TCBMsg{CopyBckMsg: apc.CopyBckMsg{Transcode: apc.TranscodeTar2Tgz}}, "a/b.tar", "a/b.tgz"},
+		{apc.TCBMsg{CopyBckMsg: apc.CopyBckMsg{Transcode: apc.TranscodeTar2Tgz}}, "a/b.txt", "a/b.txt"},
+		{apc.TCBMsg{CopyBckMsg: apc.CopyBckMsg{Transcode: apc.TranscodeTar2Tgz}}, "a/tar", "a/tar"},
+		{apc.TCBMsg{CopyBckMsg: apc.CopyBckMsg{Transcode: apc.TranscodeTar2Tgz, Prepend: "x/"}}, "b.tar", "x/b.tgz"},
+		// extension mapping applies to the transcoded name
+		{apc.TCBMsg{CopyBckMsg: apc.CopyBckMsg{Transcode: apc.TranscodeTar2Tgz},
+			Ext: cos.StrKVs{"tgz": "tar.gz"}}, "b.tar", "b.tar.gz"},
+		{apc.TCBMsg{CopyBckMsg: apc.CopyBckMsg{Transcode: apc.TranscodeTar2Tgz, Prepend: "x/"},
+			Ext: cos.StrKVs{"txt": "log"}}, "b.txt", "x/b.log"},
+		{apc.TCBMsg{Ext: cos.StrKVs{"tar": "tgz"}}, "b.tar", "b.tgz"}, // (renaming only)
+	}
+	for _, test := range tests {
+		out := test.msg.ToName(test.name)
+		tassert.Errorf(t, out == test.out, "%q (%+v): expected %q, got %q", test.name, test.msg, test.out, out)
+	}
+}
+
+func TestTranscodeDP(t *testing.T) {
+	out := tools.PrepareObjects(t, tools.ObjectsDesc{
+		CTs:           []tools.ContentTypeDesc{{Type: fs.ObjectType, ContentCnt: 1}},
+		MountpathsCnt: 1,
+		ObjectSize:    cos.KiB,
+	})
+	_, err := NewTranscodeDP("tar2zip")
+	tassert.Errorf(t, err != nil, "expected invalid transcode to fail")
+	dp, err := NewTranscodeDP(apc.TranscodeTar2Tgz)
+	tassert.CheckFatal(t, err)
+
+	t.Run("tar2tgz", func(t *testing.T) {
+		data := makeTar(t, 64*cos.KiB)
+		lom := createObj(t, &out.Bck, "shard.tar", data)
+		defer core.FreeLOM(lom)
+
+		reader, oah, err := dp.Reader(lom, false, false)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, oah.SizeBytes() == cos.ContentLengthUnknown, "expected unknown size, got %d", oah.SizeBytes())
+		gzr, err := gzip.NewReader(reader)
+		tassert.CheckFatal(t, err)
+		got, err := io.ReadAll(gzr)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(got, data), "round trip: expected %d bytes, got %d (or content differs)",
+			len(data), len(got))
+		reader.Close()
+		checkUnlocked(t, lom)
+	})
+
+	t.Run("pass-through", func(t *testing.T) {
+		data := []byte("not a tarball")
+		lom := createObj(t, &out.Bck, "a.txt", data)
+		defer core.FreeLOM(lom)
+
+		reader, oah, err := dp.Reader(lom, false, false)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, oah.SizeBytes() == int64(len(data)), "expected size %d, got %d", len(data), oah.SizeBytes())
+		got, err := io.ReadAll(reader)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, bytes.Equal(got, data), "expected %q, got %q", data, got)
+		reader.Close()
+		checkUnlocked(t, lom)
+	})
+
+	// the consumer fails (e.g., PUT or send error) and closes the reader midway:
+	// the transcoding goroutine must exit and release the source
+	t.Run("close-midway", func(t *testing.T) {
+		lom := createObj(t, &out.Bck, "large.tar", makeTar(t, 8*cos.MiB))
+		defer core.FreeLOM(lom)
+
+		reader, _, err := dp.Reader(lom, false, false)
+		tassert.CheckFatal(t, err)
+		_, err = io.ReadFull(reader, make([]byte, cos.KiB))
+		tassert.CheckFatal(t, err)
+		reader.Close()
+		checkUnlocked(t, lom)
+	})
+}
+
+func makeTar(t *testing.T, size int) []byte {
+	var (
+		buf  bytes.Buffer
+		tw   = tar.NewWriter(&buf)
+		data = make([]byte, size)
+	)
+	_, err := rand.Read(data)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, tw.WriteHeader(&tar.Header{Name: "file", Mode: 0o644, Size: int64(size), Typeflag: tar.TypeReg}))
+	_, err = tw.Write(data)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, tw.Close())
+	return buf.Bytes()
+}
+
+func createObj(t *testing.T, bck *cmn.Bck, name string, data []byte) *core.LOM {
+	lom := core.AllocLOM(name)
+	tassert.CheckFatal(t, lom.InitBck(bck))
+	tassert.CheckFatal(t, os.WriteFile(lom.FQN, data, cos.PermRWR))
+	lom.SetSize(int64(len(data)))
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	tassert.CheckFatal(t, lom.Persist())
+	return lom
+}
+
+// the source is read-locked while being read (see core.LOM.NewDeferROC)
+func checkUnlocked(t *testing.T, lom *core.LOM) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		if lom.TryLock(true) {
+			lom.Unlock(true)
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Errorf("%s: remains locked (reader not closed?)", lom.Cname())
+}
@@ -8,7 +8,6 @@ package xs
 import (
 	"fmt"
 	"io"
-	"runtime"
 	"sync"
 	"time"
 
@@ -29,6 +28,9 @@ import (
 
 const PrefixTcoID = "tco-"
 
+// when work channel is full: log every so many times
+const chanFullWarnEvery = 100
+
 type (
 	tcoFactory struct {
 		args *xreg.TCObjsArgs
@@ -39,12 +41,25 @@ type (
 			m   map[string]*tcowi
 			mtx sync.RWMutex
 		}
+		// failed to copy (or transform) - the first `max` names, and the total count
+		failed struct {
+			names []string
+			cnt   atomic.Int64
+			mtx   sync.Mutex
+			max   int
+		}
 		args     *xreg.TCObjsArgs
 		workCh   chan *cmn.TCObjsMsg
 		chanFull atomic.Int64
 		streamingX
 		owt cmn.OWT
 	}
+	ExtTCObjsStats struct {
+		FailedNames []string `json:"tco.failed.names,omitempty"`
+		FailedCnt   int64    `json:"tco.failed.n,string,omitempty"`
+		// number of times the work channel was full (backpressure) - see Do()
+		ChanFull int64 `json:"tco.chan.full.n,string,omitempty"`
+	}
 	tcowi struct {
 		r   *XactTCObjs
 		msg *cmn.TCObjsMsg
@@ -84,6 +99,10 @@ func (p *tcoFactory) Start() error {
 	workCh := make(chan *cmn.TCObjsMsg, maxNumInParallel)
 	r := &XactTCObjs{streamingX: streamingX{p: &p.streamingF, config: cmn.GCO.Get()}, args: p.args, workCh: workCh}
 	r.pending.m = make(map[string]*tcowi, maxNumInParallel)
+	r.failed.max = r.config.TCB.MaxFailedNames
+	if r.failed.max == 0 {
+		r.failed.max = apc.DfltTCOFailedNames
+	}
 	r.owt = cmn.OwtCopy
 	if p.kind == apc.ActETLObjects {
 		r.owt = cmn.OwtTransform
@@ -131,9 +150,36 @@ func (r *XactTCObjs) Snap() (snap *core.Snap) {
 	snap.IdleX = r.IsIdle()
 	f, t := r.FromTo()
 	snap.SrcBck, snap.DstBck = f.Clone(), t.Clone()
+
+	var (
+		cnt      = r.failed.cnt.Load()
+		chanFull = r.chanFull.Load()
+	)
+	if cnt == 0 && chanFull == 0 {
+		return
+	}
+	ext := &ExtTCObjsStats{FailedCnt: cnt, ChanFull: chanFull}
+	if cnt > 0 {
+		r.failed.mtx.Lock()
+		ext.FailedNames = make([]string, len(r.failed.names))
+		copy(ext.FailedNames, r.failed.names)
+		r.failed.mtx.Unlock()
+	}
+	snap.Ext = ext
 	return
 }
 
+// keep (bounded) record of failed objects to report via Snap() -
+// in particular, when running with continue-on-error
+func (r *XactTCObjs) addFailed(objName string) {
+	r.failed.cnt.Inc()
+	r.failed.mtx.Lock()
+	if len(r.failed.names) < r.failed.max {
+		r.failed.names = append(r.failed.names, objName)
+	}
+	r.failed.mtx.Unlock()
+}
+
 func (r *XactTCObjs) Begin(msg *cmn.TCObjsMsg) {
 	wi := &tcowi{r: r, msg: msg}
 	r.pending.mtx.Lock()
@@ -172,6 +218,7 @@ func (r *XactTCObjs) Run(wg *sync.WaitGroup) {
 			// run
 			var wg *sync.WaitGroup
 			if err = lrit.init(r, &msg.ListRange, r.Bck()); err == nil {
+				lrit.workers(msg.NumWorkers)
 				if msg.Sync && lrit.lrp != lrpList {
 					wg = &sync.WaitGroup{}
 					wg.Add(1)
@@ -208,18 +255,32 @@ fin:
 }
 
 // more work
-func (r *XactTCObjs) Do(msg *cmn.TCObjsMsg) {
+// - non-blocking send when there's room in the work channel
+// - otherwise, block for up to `timeout.max_host_busy` (backpressure) while watching for abort
+func (r *XactTCObjs) Do(msg *cmn.TCObjsMsg) error {
 	r.IncPending()
-	r.workCh <- msg
-
-	if l, c := len(r.workCh), cap(r.workCh); l > c/2 {
-		runtime.Gosched() // poor man's throttle
-		if l == c {
-			cnt := r.chanFull.Inc()
-			if (cnt >= 10 && cnt <= 20) || (cnt > 0 && cmn.Rom.FastV(5, cos.SmoduleXs)) {
-				nlog.Errorln("work channel full", r.Name())
-			}
-		}
+	select {
+	case r.workCh <- msg:
+		return nil
+	default:
+	}
+
+	// work channel is full
+	cnt := r.chanFull.Inc()
+	if cnt == 1 || cnt%chanFullWarnEvery == 0 || cmn.Rom.FastV(5, cos.SmoduleXs) {
+		nlog.Warningln(r.Name(), "work channel full, cnt:", cnt)
+	}
+	timer := time.NewTimer(r.config.Timeout.MaxHostBusy.D())
+	defer timer.Stop()
+	select {
+	case r.workCh <- msg:
+		return nil
+	case <-r.ChanAbort():
+		r.DecPending()
+		return cmn.NewErrAborted(r.Name(), "do", r.AbortErr())
+	case <-timer.C:
+		r.DecPending()
+		return cmn.NewErrBusy("job", r, "work channel full for "+r.config.Timeout.MaxHostBusy.String())
 	}
 }
 
@@ -305,10 +366,13 @@ func (r *XactTCObjs) _put(hdr *transport.ObjHdr, objReader io.Reader, lom *core.
 ///////////
 
 func (wi *tcowi) do(lom *core.LOM, lrit *lriterator) {
-	var (
-		objNameTo = wi.msg.ToName(lom.ObjName)
-		buf, slab = core.T.PageMM().Alloc()
-	)
+	objNameTo := wi.msg.ToName(lom.ObjName)
+	if objNameTo == "" {
+		wi.r.AddErr(fmt.Errorf("%s: empty destination name for %s", wi.r.Name(), lom.Cname()), 0)
+		wi.r.addFailed(lom.ObjName)
+		return
+	}
+	buf, slab := core.T.PageMM().Alloc()
 
 	// under ETL, the returned sizes of transformed objects are unknown (`cos.ContentLengthUnknown`)
 	// until after the transformation; here we are disregarding the size anyway as the stats
@@ -334,6 +398,7 @@ func (wi *tcowi) do(lom *core.LOM, lrit *lriterator) {
 	if err != nil {
 		if !cos.IsNotExist(err, 0) || lrit.lrp == lrpList {
 			wi.r.AddErr(err, 5, cos.SmoduleXs)
+			wi.r.addFailed(lom.ObjName)
 		}
 	} else if cmn.Rom.FastV(5, cos.SmoduleXs) {
 		nlog.Infoln(wi.r.Name()+":", lom.Cname(), "=>", wi.r.args.BckTo.Cname(objNameTo))
@@ -365,11 +430,12 @@ func (r *XactTCObjs) prune(lrit *lriterator, smap *meta.Smap, pt *cos.ParsedTemp
 		return
 	}
 
-	// same range iterator but different bucket
+	// same range iterator but different bucket (and sequential)
 	debug.Assert(lrit.lrp == lrpRange)
 	syncit := *lrit
 	syncit.pt = pt
 	syncit.bck = rp.bckTo
+	syncit.workCh, syncit.nwork = nil, 0
 	syncwi := &syncwi{&rp} // reusing only prune.do (and not init/run/wait)
 	syncit.run(syncwi, smap)
 }